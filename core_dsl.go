@@ -20,9 +20,12 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/onsi-experimental/ginkgo/v2/formatter"
 	"github.com/onsi-experimental/ginkgo/v2/internal"
 	"github.com/onsi-experimental/ginkgo/v2/internal/global"
@@ -39,18 +42,31 @@ var deprecationTracker = types.NewDeprecationTracker()
 var suiteConfig = types.NewDefaultSuiteConfig()
 var reporterConfig = types.NewDefaultReporterConfig()
 var suiteDidRun = false
+var suitesRun = 0
 var outputInterceptor internal.OutputInterceptor
 var client parallel_support.Client
 
+// testingTB is the testing.TB passed in to the currently running RunSpecs, if any.  It backs GinkgoTB().
+var testingTB testing.TB
+
+// inProcessTreeConstructionErrors is only set while RunSuiteInProcess builds its spec tree.  Errors that would
+// otherwise cause Ginkgo to exit are recorded here instead so that they can be returned to the caller.
+var inProcessTreeConstructionErrors *[]error
+
 func init() {
 	var err error
 	flagSet, err = types.BuildTestSuiteFlagSet(&suiteConfig, &reporterConfig)
 	exitIfErr(err)
 	GinkgoWriter = internal.NewWriter(os.Stdout)
+	GinkgoLogr = internal.NewGinkgoLogr(ginkgoWriterForLogr, func() int { return reporterConfig.GinkgoLogrVerbosity })
 }
 
 func exitIfErr(err error) {
 	if err != nil {
+		if inProcessTreeConstructionErrors != nil {
+			*inProcessTreeConstructionErrors = append(*inProcessTreeConstructionErrors, err)
+			return
+		}
 		if outputInterceptor != nil {
 			outputInterceptor.Shutdown()
 		}
@@ -64,6 +80,10 @@ func exitIfErr(err error) {
 
 func exitIfErrors(errors []error) {
 	if len(errors) > 0 {
+		if inProcessTreeConstructionErrors != nil {
+			*inProcessTreeConstructionErrors = append(*inProcessTreeConstructionErrors, errors...)
+			return
+		}
 		if outputInterceptor != nil {
 			outputInterceptor.Shutdown()
 		}
@@ -103,6 +123,31 @@ You can learn more at https://onsi.github.io/ginkgo/#logging-output
 */
 var GinkgoWriter GinkgoWriterInterface
 
+/*
+GinkgoLogr is a logr.Logger that writes structured key/value lines to GinkgoWriter.
+
+Because it writes to GinkgoWriter, anything logged via GinkgoLogr is attributed to the currently running spec: it is captured in the spec's
+CapturedGinkgoWriterOutput, emitted if the spec fails, and streamed immediately when running in verbose mode (ginkgo -v).
+
+GinkgoLogr only emits V-levels less than or equal to the verbosity set by --ginkgo-logr-verbosity (which defaults to 0).  Use GinkgoLogrWithVerbosity
+to construct a logger with a fixed verbosity.
+
+You can learn more at https://onsi.github.io/ginkgo/#logging-output
+*/
+var GinkgoLogr logr.Logger
+
+/*
+GinkgoLogrWithVerbosity returns a logr.Logger that, like GinkgoLogr, writes to GinkgoWriter.  Unlike GinkgoLogr it ignores --ginkgo-logr-verbosity and
+emits all V-levels less than or equal to verbosity.
+*/
+func GinkgoLogrWithVerbosity(verbosity int) logr.Logger {
+	return internal.NewGinkgoLogr(ginkgoWriterForLogr, func() int { return verbosity })
+}
+
+func ginkgoWriterForLogr() io.Writer {
+	return GinkgoWriter
+}
+
 //The interface by which Ginkgo receives *testing.T
 type GinkgoTestingT interface {
 	Fail()
@@ -208,6 +253,23 @@ func RunSpecs(t GinkgoTestingT, description string, configs ...interface{}) bool
 	}
 	suiteDidRun = true
 
+	return runSpecs(t, description, global.Suite, global.Failer, configs...)
+}
+
+// runSpecs runs the passed-in suite.  The suite and its failer are made the active global suite and failer for the duration of the run
+// so that the package-level DSL (nested container nodes, Fail, Skip, DeferCleanup, etc.) operates on the running suite.
+func runSpecs(t GinkgoTestingT, description string, suite *internal.Suite, failer *internal.Failer, configs ...interface{}) bool {
+	defaultSuiteConfig, defaultReporterConfig := suiteConfig, reporterConfig
+	previousSuite, previousFailer, previousTB := global.Suite, global.Failer, testingTB
+	global.Suite, global.Failer = suite, failer
+	if tb, ok := t.(testing.TB); ok {
+		testingTB = tb
+	}
+	defer func() {
+		suiteConfig, reporterConfig = defaultSuiteConfig, defaultReporterConfig
+		global.Suite, global.Failer, testingTB = previousSuite, previousFailer, previousTB
+	}()
+
 	configErrors := []error{}
 	for _, config := range configs {
 		switch config := config.(type) {
@@ -221,7 +283,16 @@ func RunSpecs(t GinkgoTestingT, description string, configs ...interface{}) bool
 	}
 	exitIfErrors(configErrors)
 
+	// every suite in the process shares the process's connection to the parallel server - so only one suite per process can run in parallel
+	suitesRun += 1
+	if suitesRun > 1 && suiteConfig.ParallelTotal > 1 {
+		exitIfErr(types.GinkgoErrors.MultipleSuitesInParallel())
+	}
+
 	configErrors = types.VetConfig(flagSet, suiteConfig, reporterConfig)
+	if suiteConfig.ComputedParallelGoroutines() > 1 && !internal.RuntimeReportsGoroutineLineage() {
+		configErrors = append(configErrors, types.GinkgoErrors.GoroutineParallelModeUnsupportedByRuntime(runtime.Version()))
+	}
 	if len(configErrors) > 0 {
 		fmt.Fprintf(formatter.ColorableStdErr, formatter.F("{{red}}Ginkgo detected configuration issues:{{/}}\n"))
 		for _, err := range configErrors {
@@ -254,7 +325,7 @@ func RunSpecs(t GinkgoTestingT, description string, configs ...interface{}) bool
 	}
 
 	writer := GinkgoWriter.(*internal.Writer)
-	if reporterConfig.Verbose && suiteConfig.ParallelTotal == 1 {
+	if reporterConfig.Verbose && suiteConfig.ParallelTotal == 1 && suiteConfig.ComputedParallelGoroutines() == 1 {
 		writer.SetMode(internal.WriterModeStreamAndBuffer)
 	} else {
 		writer.SetMode(internal.WriterModeBufferOnly)
@@ -264,7 +335,7 @@ func RunSpecs(t GinkgoTestingT, description string, configs ...interface{}) bool
 		registerReportAfterSuiteNodeForAutogeneratedReports(reporterConfig)
 	}
 
-	err := global.Suite.BuildTree()
+	err := suite.BuildTree()
 	exitIfErr(err)
 
 	suitePath, err := os.Getwd()
@@ -272,7 +343,15 @@ func RunSpecs(t GinkgoTestingT, description string, configs ...interface{}) bool
 	suitePath, err = filepath.Abs(suitePath)
 	exitIfErr(err)
 
-	passed, hasFocusedTests := global.Suite.Run(description, suitePath, global.Failer, reporter, writer, outputInterceptor, interrupt_handler.NewInterruptHandler(suiteConfig.Timeout, client), client, suiteConfig)
+	progressSignalRegistrar := internal.RegisterForProgressSignal
+	if suiteConfig.ParallelTotal > 1 {
+		// the CLI requests progress reports from every parallel process when ^T is sent to the process group - so don't request them again here
+		progressSignalRegistrar = internal.RegisterForDirectedProgressSignal
+	}
+
+	interruptHandler := interrupt_handler.NewInterruptHandler(suiteConfig.Timeout, client)
+	passed, hasFocusedTests := suite.Run(description, suitePath, failer, reporter, writer, outputInterceptor, interruptHandler, client, progressSignalRegistrar, suiteConfig)
+	interruptHandler.Stop()
 	outputInterceptor.Shutdown()
 
 	flagSet.ValidateDeprecations(deprecationTracker)
@@ -348,6 +427,25 @@ func AbortSuite(message string, callerSkip ...int) {
 	panic(types.GinkgoErrors.UncaughtGinkgoPanic(cl))
 }
 
+/*
+GinkgoHelper marks the function it's called in as a test helper.  When a failure occurs inside a helper function, Ginkgo will skip the helper
+when it looks for the location of the failure.  Similarly, nodes defined within a helper are attributed to the helper's caller.
+
+This mirrors testing.T's Helper and obviates the need to thread callerSkip or Offset through your helpers:
+
+    func ExpectEven(n int) {
+        GinkgoHelper()
+        if n%2 != 0 {
+            Fail(fmt.Sprintf("%d is not even", n)) // the failure location will point at the caller of ExpectEven
+        }
+    }
+
+You can learn more here: https://onsi.github.io/ginkgo/#marking-helper-functions
+*/
+func GinkgoHelper() {
+	types.MarkAsHelper(1)
+}
+
 /*
 GinkgoRecover should be deferred at the top of any spawned goroutine that (may) call `Fail`
 Since Gomega assertions call fail, you should throw a `defer GinkgoRecover()` at the top of any goroutine that
@@ -371,7 +469,9 @@ func GinkgoRecover() {
 // it handles returned errors, emits a detailed error message to help the user learn what they may have done wrong, then exits
 func pushNode(node internal.Node, errors []error) bool {
 	exitIfErrors(errors)
-	exitIfErr(global.Suite.PushNode(node))
+	if len(errors) == 0 {
+		exitIfErr(global.Suite.PushNode(node))
+	}
 	return true
 }
 
@@ -467,7 +567,8 @@ especially in the context of integration tests that capture complex or lengthy w
 By allows you to document such flows.  By may be called within a Setup or Subject node (It, BeforeEach, etc...)
 and will simply log the passed in text to the GinkgoWriter.  If By is handed a function it will immediately run the function.
 
-By will also generate and attach a ReportEntry to the spec.  This will ensure that By annotations appear in Ginkgo's machine-readable reports.
+By will also generate and attach a ReportEntry to the spec and record the step in the spec's step timeline (SpecReport.Steps).  This will ensure that By annotations appear in Ginkgo's machine-readable reports.
+If the spec fails while a step is active the failure will be annotated with the innermost active step.
 
 Note that By does not generate a new Ginkgo node - rather it is simply synctactic sugar around GinkgoWriter and AddReportEntry
 You can learn more about By here: https://onsi.github.io/ginkgo/#documenting-complex-specs-by
@@ -480,12 +581,18 @@ func By(text string, callback ...func()) {
 		Text: text,
 	}
 	t := time.Now()
+	stepIndex := global.Suite.BeginStep(types.SpecStep{
+		Text:         text,
+		CodeLocation: types.NewCodeLocation(1),
+		StartTime:    t,
+	}, len(callback) == 1)
 	AddReportEntry("By Step", ReportEntryVisibilityNever, Offset(1), &value, t)
 	formatter := formatter.NewWithNoColorBool(reporterConfig.NoColor)
 	GinkgoWriter.Println(formatter.F("{{bold}}STEP:{{/}} %s {{gray}}%s{{/}}", text, t.Format(types.GINKGO_TIME_FORMAT)))
 	if len(callback) == 1 {
 		callback[0]()
 		value.Duration = time.Since(t)
+		global.Suite.EndStep(stepIndex)
 	}
 	if len(callback) > 1 {
 		panic("just one callback per By, please")
@@ -498,11 +605,15 @@ When running in parallel, each parallel process will call BeforeSuite.
 
 You may only register *one* BeforeSuite handler per test suite.  You typically do so in your bootstrap file at the top level.
 
+BeforeSuite can take a func() body, or an interruptible func(SpecContext)/func(context.Context) body.
+
 You cannot nest any other Ginkgo nodes within a BeforeSuite node's closure.
 You can learn more here: https://onsi.github.io/ginkgo/#suite-setup-and-cleanup-beforesuite-and-aftersuite
 */
-func BeforeSuite(body func()) bool {
-	return pushNode(internal.NewNode(deprecationTracker, types.NodeTypeBeforeSuite, "", body))
+func BeforeSuite(body interface{}, args ...interface{}) bool {
+	combinedArgs := []interface{}{body}
+	combinedArgs = append(combinedArgs, args...)
+	return pushNode(internal.NewNode(deprecationTracker, types.NodeTypeBeforeSuite, "", combinedArgs...))
 }
 
 /*
@@ -513,11 +624,15 @@ When running in parallel, each parallel process will call AfterSuite.
 
 You may only register *one* AfterSuite handler per test suite.  You typically do so in your bootstrap file at the top level.
 
+AfterSuite can take a func() body, or an interruptible func(SpecContext)/func(context.Context) body.
+
 You cannot nest any other Ginkgo nodes within an AfterSuite node's closure.
 You can learn more here: https://onsi.github.io/ginkgo/#suite-setup-and-cleanup-beforesuite-and-aftersuite
 */
-func AfterSuite(body func()) bool {
-	return pushNode(internal.NewNode(deprecationTracker, types.NodeTypeAfterSuite, "", body))
+func AfterSuite(body interface{}, args ...interface{}) bool {
+	combinedArgs := []interface{}{body}
+	combinedArgs = append(combinedArgs, args...)
+	return pushNode(internal.NewNode(deprecationTracker, types.NodeTypeAfterSuite, "", combinedArgs...))
 }
 
 /*
@@ -648,6 +763,8 @@ DeferCleanup can be passed:
 
 will register a cleanup handler that will set the environment variable "FOO" to it's current value (obtained by os.GetEnv("FOO")) after the spec runs and then sets the environment variable "FOO" to "BAR" for the current spec.
 
+If the function's first argument is a SpecContext (or context.Context) and you don't provide a value for it, Ginkgo will pass in the context of the running cleanup node.  You can also pass a NodeTimeout decorator to DeferCleanup to bound the time the cleanup callback may take.
+
 When DeferCleanup is called in BeforeEach, JustBeforeEach, It, AfterEach, or JustAfterEach the registered callback will be invoked when the spec completes (i.e. it will behave like an AfterEach node)
 When DeferCleanup is called in BeforeAll or AfterAll the registered callback will be invoked when the ordered container completes (i.e. it will behave like an AfterAll node)
 When DeferCleanup is called in BeforeSuite, SynchronizedBeforeSuite, AfterSuite, or SynchronizedAfterSuite the registered callback will be invoked when the suite completes (i.e. it will behave like an AfterSuite node)
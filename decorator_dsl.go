@@ -20,6 +20,66 @@ You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorat
 */
 type FlakeAttempts = internal.FlakeAttempts
 
+/*
+MustPassRepeatedly(uint N) is a decorator that allows you to repeat the execution of individual specs or spec containers.  Ginkgo will run them up to `N` times and fail the spec on the first failing attempt.
+
+Use MustPassRepeatedly to harden new specs and catch flakes before they merge.  You can learn more here: https://onsi.github.io/ginkgo/#repeating-spec-runs-and-managing-flaky-specs
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+type MustPassRepeatedly = internal.MustPassRepeatedly
+
+/*
+SpecPriority(int) is a decorator that allows you to assign a priority to individual specs or spec containers.  Higher priority specs are scheduled before lower priority specs.
+Specs with the same priority retain their randomized order.  The default priority is 0; the most deeply nested SpecPriority wins.
+
+Use SpecPriority to ensure long-running specs are dispatched early when running in parallel.  You can learn more here: https://onsi.github.io/ginkgo/#prioritizing-specs
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+type SpecPriority = internal.SpecPriority
+
+/*
+NodeTimeout(time.Duration) is a decorator that allows you to specify a timeout for an individual node.  The node must accept a SpecContext (or context.Context).
+If the node does not complete within the timeout, Ginkgo cancels the node's context and fails the spec with a timed out state.
+
+You can learn more here: https://onsi.github.io/ginkgo/#spec-timeouts-and-interruptible-nodes
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+type NodeTimeout = internal.NodeTimeout
+
+/*
+SpecTimeout(time.Duration) is a decorator that allows you to specify a timeout for an entire spec, including its setup nodes.  SpecTimeout can only be applied to It nodes and the It must accept a SpecContext (or context.Context).
+If the spec does not complete within the timeout, Ginkgo cancels the context of the currently running node and fails the spec with a timed out state.
+
+You can learn more here: https://onsi.github.io/ginkgo/#spec-timeouts-and-interruptible-nodes
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+type SpecTimeout = internal.SpecTimeout
+
+/*
+GracePeriod(time.Duration) is a decorator that allows you to specify how long Ginkgo should wait for a node to exit after its context has been cancelled.  The node must accept a SpecContext (or context.Context).
+If the node does not exit within the grace period Ginkgo abandons it, reports its goroutine's stack, and ignores any failures it subsequently reports.  With Go 1.21 and later failures reported by goroutines the node launched are ignored too.  GracePeriod overrides the --grace-period flag.
+
+You can learn more here: https://onsi.github.io/ginkgo/#spec-timeouts-and-interruptible-nodes
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+type GracePeriod = internal.GracePeriod
+
+/*
+SpecContext is the context object passed into nodes that are subject to a timeout or need to be notified of an interrupt.  It implements the standard context.Context interface but also contains additional helpers to provide an extensibility point for Ginkgo.  (As an example, Gomega's Eventually can use the methods defined on SpecContext to provide deeper integration with Ginkgo).
+
+You can learn more about SpecContext here: https://onsi.github.io/ginkgo/#spec-timeouts-and-interruptible-nodes
+*/
+type SpecContext = internal.SpecContext
+
+/*
+PollProgressAfter(time.Duration) is a decorator that allows you to specify how long a node may run before Ginkgo emits a progress report for it.  If the node is still running after the duration elapses, Ginkgo will emit a progress report and will continue to emit progress reports every --poll-progress-interval until the node completes.
+PollProgressAfter overrides the --poll-progress-after flag and can be applied to subject and setup nodes.
+
+You can learn more here: https://onsi.github.io/ginkgo/#getting-visibility-into-long-running-specs
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+type PollProgressAfter = internal.PollProgressAfter
+
 /*
 Focus is a decorator that allows you to mark a spec or container as focused.  Identical to FIt and FDescribe.
 
@@ -54,6 +114,24 @@ You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorat
 */
 const Ordered = internal.Ordered
 
+/*
+OncePerOrdered is a decorator that allows you to mark outer BeforeEach, AfterEach, JustBeforeEach, and JustAfterEach setup nodes to run once
+per ordered context.  Normally these setup nodes run around each individual spec, with OncePerOrdered they will run once around the set of specs in an ordered container.
+The behavior for non-Ordered containers/specs is unchanged.
+
+You can learn more here: https://onsi.github.io/ginkgo/#setup-around-ordered-containers-the-onceperordered-decorator
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+const OncePerOrdered = internal.OncePerOrdered
+
+/*
+ContinueOnFailure is a decorator that allows you to mark an Ordered container to continue running specs even if failures occur.  Ordinarily an ordered container will stop running specs after the first failure occurs.  Note that if a BeforeAll or a BeforeEach/JustBeforeEach annotated with OncePerOrdered fails then no further specs will run as the failure is treated as a failure of the shared setup.
+
+You can learn more here: https://onsi.github.io/ginkgo/#failure-handling-in-ordered-containers
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+const ContinueOnFailure = internal.ContinueOnFailure
+
 /*
 Label decorates specs with Labels.  Multiple labels can be passed to Label and these can be arbitrary strings but must not include the following characters: "&|!,()/".
 Labels can be applied to container and subject nodes, but not setup nodes.  You can provide multiple Labels to a given node and a spec's labels is the union of all labels in its node hierarchy.
@@ -70,3 +148,20 @@ Labels are the type for spec Label decorators.  Use Label(...) to construct Labe
 You can learn more here: https://onsi.github.io/ginkgo/#spec-labels
 */
 type Labels = internal.Labels
+
+/*
+Exclusive decorates specs that need exclusive access to a shared resource (e.g. a database or a fixed port).  When running in parallel, specs that share an Exclusive key never run at the same time
+while specs with other keys, or no keys, continue to run concurrently.  Exclusive can be applied to container and subject nodes and a spec's keys are the union of all the keys in its node hierarchy.
+
+Use Exclusive instead of Serial when a spec only needs exclusive access to a specific resource.  You can learn more here: https://onsi.github.io/ginkgo/#exclusive-specs
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+func Exclusive(keys ...string) ExclusiveKeys {
+	return ExclusiveKeys(keys)
+}
+
+/*
+ExclusiveKeys are the type for spec Exclusive decorators.  Use Exclusive(...) to construct ExclusiveKeys.
+You can learn more here: https://onsi.github.io/ginkgo/#exclusive-specs
+*/
+type ExclusiveKeys = internal.ExclusiveKeys
@@ -13,7 +13,8 @@
 // indeed different tests.
 //
 // Note that this package is not intended to be used as part of normal ginkgo setups, and
-// usually, you will never need to worry about the global state of ginkgo
+// usually, you will never need to worry about the global state of ginkgo.  If you need a package
+// to host several independent suites, use `ginkgo.NewSuite()` instead.
 package globals
 
 import "github.com/onsi-experimental/ginkgo/v2/internal/global"
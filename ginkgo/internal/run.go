@@ -2,6 +2,7 @@ package internal
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/onsi-experimental/ginkgo/v2/formatter"
 	"github.com/onsi-experimental/ginkgo/v2/ginkgo/command"
+	"github.com/onsi-experimental/ginkgo/v2/internal"
 	"github.com/onsi-experimental/ginkgo/v2/internal/parallel_support"
 	"github.com/onsi-experimental/ginkgo/v2/reporters"
 	"github.com/onsi-experimental/ginkgo/v2/types"
@@ -27,7 +29,13 @@ func RunCompiledSuite(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterC
 		return suite
 	}
 
-	if suite.IsGinkgo && cliConfig.ComputedProcs() > 1 {
+	if suite.IsGinkgo && ginkgoConfig.DryRun {
+		// dry runs don't run any spec code so there is nothing to distribute - we always perform them in a single process
+		suite = runSerial(suite, ginkgoConfig, reporterConfig, cliConfig, goFlagsConfig, additionalArgs)
+	} else if suite.IsGinkgo && strings.ToLower(ginkgoConfig.ParallelMode) == "goroutines" {
+		ginkgoConfig.ParallelGoroutines = cliConfig.ComputedProcs()
+		suite = runSerial(suite, ginkgoConfig, reporterConfig, cliConfig, goFlagsConfig, additionalArgs)
+	} else if suite.IsGinkgo && cliConfig.ComputedProcs() > 1 {
 		suite = runParallel(suite, ginkgoConfig, reporterConfig, cliConfig, goFlagsConfig, additionalArgs)
 	} else if suite.IsGinkgo {
 		suite = runSerial(suite, ginkgoConfig, reporterConfig, cliConfig, goFlagsConfig, additionalArgs)
@@ -38,6 +46,50 @@ func RunCompiledSuite(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterC
 	return suite
 }
 
+// DryRunCompiledSuite performs a dry run of the compiled suite, in a single process and without emitting any output, and returns the resulting Report
+func DryRunCompiledSuite(suite TestSuite, ginkgoConfig types.SuiteConfig, goFlagsConfig types.GoFlagsConfig, additionalArgs []string) (types.Report, error) {
+	reportDir, err := os.MkdirTemp("", "ginkgo-dry-run")
+	if err != nil {
+		return types.Report{}, err
+	}
+	defer os.RemoveAll(reportDir)
+
+	ginkgoConfig.DryRun = true
+	ginkgoConfig.ParallelProcess, ginkgoConfig.ParallelTotal, ginkgoConfig.ParallelHost = 1, 1, ""
+	reporterConfig := types.NewDefaultReporterConfig()
+	reporterConfig.Succinct, reporterConfig.NoColor = true, true
+	reporterConfig.JSONReport = filepath.Join(reportDir, "report.json")
+
+	args, err := types.GenerateGinkgoTestRunArgs(ginkgoConfig, reporterConfig, goFlagsConfig)
+	if err != nil {
+		return types.Report{}, err
+	}
+	args = append([]string{"--test.timeout=0"}, args...)
+	args = append(args, additionalArgs...)
+
+	cmd, buf := buildAndStartCommand(suite, args, false)
+	cmd.Wait()
+
+	exitStatus := cmd.ProcessState.Sys().(syscall.WaitStatus).ExitStatus()
+	if exitStatus != 0 && exitStatus != types.GINKGO_FOCUS_EXIT_CODE {
+		return types.Report{}, fmt.Errorf("dry run of %s failed:\n%s", suite.Path, buf.String())
+	}
+
+	data, err := os.ReadFile(reporterConfig.JSONReport)
+	if err != nil {
+		return types.Report{}, err
+	}
+	reports := []types.Report{}
+	err = json.Unmarshal(data, &reports)
+	if err != nil {
+		return types.Report{}, err
+	}
+	if len(reports) != 1 {
+		return types.Report{}, fmt.Errorf("dry run of %s generated %d reports, expected 1", suite.Path, len(reports))
+	}
+	return reports[0], nil
+}
+
 func buildAndStartCommand(suite TestSuite, args []string, pipeToStdout bool) (*exec.Cmd, *bytes.Buffer) {
 	buf := &bytes.Buffer{}
 	cmd := exec.Command(suite.PathToCompiledTest, args...)
@@ -90,7 +142,12 @@ func runSerial(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterConfig t
 
 	cmd, buf := buildAndStartCommand(suite, args, true)
 
+	// forward progress signals sent to the CLI on to the suite.  the suite already receives signals sent to the whole process group (e.g. SIGINFO via ^T) so those aren't forwarded
+	cancelProgressHandler := internal.RegisterForDirectedProgressSignal(func() {
+		cmd.Process.Signal(internal.DIRECTED_PROGRESS_SIGNALS[0])
+	})
 	cmd.Wait()
+	cancelProgressHandler()
 
 	exitStatus := cmd.ProcessState.Sys().(syscall.WaitStatus).ExitStatus()
 	suite.HasProgrammaticFocus = (exitStatus == types.GINKGO_FOCUS_EXIT_CODE)
@@ -124,9 +181,22 @@ func runParallel(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterConfig
 
 	server, err := parallel_support.NewServer(numProcs, reporters.NewDefaultReporter(reporterConfig, formatter.ColorableStdOut))
 	command.AbortIfError("Failed to start parallel spec server", err)
+	if scheduleFile := ScheduleFile(cliConfig); scheduleFile != "" {
+		// the schedule only affects the order specs run in so we fall back to the usual order rather than fail the run
+		runTimes, err := LoadSpecRunTimes(scheduleFile, suite)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load spec run times from %s, specs will not be scheduled by run time:\n%s\n", scheduleFile, err.Error())
+		} else {
+			server.SetSpecRunTimes(runTimes)
+		}
+	}
 	server.Start()
 	defer server.Close()
 
+	// progress signals sent to the CLI are fanned out to all the parallel processes via the server
+	cancelProgressHandler := internal.RegisterForProgressSignal(server.RequestProgressReport)
+	defer cancelProgressHandler()
+
 	for proc := 1; proc <= numProcs; proc++ {
 		procGinkgoConfig := ginkgoConfig
 		procGinkgoConfig.ParallelProcess, procGinkgoConfig.ParallelTotal, procGinkgoConfig.ParallelHost = proc, numProcs, server.Address()
@@ -198,12 +268,17 @@ func runParallel(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterConfig
 		fmt.Fprintf(os.Stderr, "** End **")
 	}
 
+	err = UpdateScheduleCache(cliConfig, suite, server.GetObservedSpecReports())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to update the schedule cache in %s:\n%s\n", cliConfig.OutputDir, err.Error())
+	}
+
 	for proc := 1; proc <= cliConfig.ComputedProcs(); proc++ {
 		output := procOutput[proc-1].String()
 		if proc == 1 && checkForNoTestsWarning(procOutput[0]) && cliConfig.RequireSuite {
 			suite.State = TestSuiteStateFailed
 		}
-		if strings.Contains(output, "deprecated Ginkgo functionality") {
+		if strings.Contains(output, "deprecated Ginkgo functionality") || strings.Contains(output, "Multiple Suites in Parallel") {
 			fmt.Fprintln(os.Stderr, output)
 		}
 	}
@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+const SCHEDULE_CACHE_FILENAME = "ginkgo-schedule.json"
+
+// ScheduleFile returns the JSON report parallel runs should be scheduled from: --schedule-from if set, otherwise the schedule cache in --output-dir if it exists
+func ScheduleFile(cliConfig types.CLIConfig) string {
+	if cliConfig.ScheduleFrom != "" {
+		return cliConfig.ScheduleFrom
+	}
+	if cliConfig.OutputDir != "" {
+		cache := filepath.Join(cliConfig.OutputDir, SCHEDULE_CACHE_FILENAME)
+		if _, err := os.Stat(cache); err == nil {
+			return cache
+		}
+	}
+	return ""
+}
+
+// LoadSpecRunTimes returns the run time of every spec in the suite that ran in the JSON report at path, keyed by spec ID
+func LoadSpecRunTimes(path string, suite TestSuite) (map[string]time.Duration, error) {
+	reports, err := loadJSONReports(path)
+	if err != nil {
+		return nil, err
+	}
+	runTimes := map[string]time.Duration{}
+	for _, report := range reports {
+		// spec IDs are only unique within a suite
+		if !isSuitePath(report.SuitePath, suite) {
+			continue
+		}
+		for _, specReport := range report.SpecReports {
+			if specReport.SpecID == "" || !specReport.LeafNodeType.Is(types.NodeTypeIt) || specReport.State.Is(types.SpecStateSkipped|types.SpecStatePending) {
+				continue
+			}
+			if specReport.RunTime > runTimes[specReport.SpecID] {
+				runTimes[specReport.SpecID] = specReport.RunTime
+			}
+		}
+	}
+	return runTimes, nil
+}
+
+// UpdateScheduleCache records the spec reports observed while running the suite in the schedule cache in --output-dir
+func UpdateScheduleCache(cliConfig types.CLIConfig, suite TestSuite, specReports types.SpecReports) error {
+	if cliConfig.OutputDir == "" || len(specReports) == 0 {
+		return nil
+	}
+	cache := filepath.Join(cliConfig.OutputDir, SCHEDULE_CACHE_FILENAME)
+	reports := []types.Report{}
+	if _, err := os.Stat(cache); err == nil {
+		reports, err = loadJSONReports(cache)
+		if err != nil {
+			return err
+		}
+	}
+
+	// specs that did not run this time (e.g. because they were filtered out) keep their previously cached run times
+	var entry *types.Report
+	for i := range reports {
+		if reports[i].SuitePath == suite.AbsPath() {
+			entry = &reports[i]
+		}
+	}
+	if entry == nil {
+		reports = append(reports, types.Report{SuitePath: suite.AbsPath()})
+		entry = &reports[len(reports)-1]
+	}
+	indices := map[string]int{}
+	for i, specReport := range entry.SpecReports {
+		indices[specReport.SpecID] = i
+	}
+	for _, specReport := range specReports {
+		if i, ok := indices[specReport.SpecID]; ok {
+			entry.SpecReports[i] = specReport
+		} else {
+			entry.SpecReports = append(entry.SpecReports, specReport)
+		}
+	}
+
+	data, err := json.Marshal(reports)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(cache, data, 0666)
+}
+
+// isSuitePath returns true if path refers to the suite.  Suites record their working directory as their path in JSON reports, which may have symlinks resolved.
+func isSuitePath(path string, suite TestSuite) bool {
+	if path == suite.AbsPath() {
+		return true
+	}
+	resolvedPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return false
+	}
+	resolvedSuitePath, err := filepath.EvalSymlinks(suite.AbsPath())
+	return err == nil && resolvedPath == resolvedSuitePath
+}
+
+func loadJSONReports(path string) ([]types.Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	reports := []types.Report{}
+	err = json.Unmarshal(data, &reports)
+	return reports, err
+}
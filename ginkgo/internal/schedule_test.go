@@ -0,0 +1,116 @@
+package internal_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	"github.com/onsi-experimental/ginkgo/v2/ginkgo/internal"
+	"github.com/onsi-experimental/ginkgo/v2/reporters"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scheduling parallel runs", func() {
+	var outputDir string
+	var cliConfig types.CLIConfig
+
+	it := func(id string, state types.SpecState, runTime time.Duration) types.SpecReport {
+		return types.SpecReport{LeafNodeType: types.NodeTypeIt, SpecID: id, State: state, RunTime: runTime}
+	}
+
+	BeforeEach(func() {
+		outputDir = GinkgoT().TempDir()
+		cliConfig = types.NewDefaultCLIConfig()
+	})
+
+	Describe("ScheduleFile", func() {
+		It("returns --schedule-from when it is set", func() {
+			cliConfig.ScheduleFrom = "report.json"
+			cliConfig.OutputDir = outputDir
+			Ω(os.WriteFile(filepath.Join(outputDir, internal.SCHEDULE_CACHE_FILENAME), []byte("[]"), 0666)).Should(Succeed())
+			Ω(internal.ScheduleFile(cliConfig)).Should(Equal("report.json"))
+		})
+
+		It("returns the schedule cache in --output-dir when it exists", func() {
+			cliConfig.OutputDir = outputDir
+			Ω(internal.ScheduleFile(cliConfig)).Should(BeEmpty())
+			Ω(os.WriteFile(filepath.Join(outputDir, internal.SCHEDULE_CACHE_FILENAME), []byte("[]"), 0666)).Should(Succeed())
+			Ω(internal.ScheduleFile(cliConfig)).Should(Equal(filepath.Join(outputDir, internal.SCHEDULE_CACHE_FILENAME)))
+		})
+
+		It("returns nothing otherwise", func() {
+			Ω(internal.ScheduleFile(cliConfig)).Should(BeEmpty())
+		})
+	})
+
+	Describe("LoadSpecRunTimes", func() {
+		var suite internal.TestSuite
+
+		BeforeEach(func() {
+			suite = internal.TestSuite{Path: "/path/to/a", PackageName: "a", IsGinkgo: true}
+		})
+
+		It("returns the run times of the suite's specs that ran in a JSON report", func() {
+			path := filepath.Join(outputDir, "report.json")
+			Ω(reporters.GenerateJSONReport(types.Report{SuitePath: suite.AbsPath(), SpecReports: types.SpecReports{
+				it("a", types.SpecStatePassed, time.Second),
+				it("b", types.SpecStateFailed, 2*time.Second),
+				it("c", types.SpecStateSkipped, 0),
+				it("d", types.SpecStatePending, 0),
+				it("", types.SpecStatePassed, time.Second),
+				{LeafNodeType: types.NodeTypeBeforeSuite, State: types.SpecStatePassed, RunTime: time.Minute},
+			}}, path)).Should(Succeed())
+
+			Ω(internal.LoadSpecRunTimes(path, suite)).Should(Equal(map[string]time.Duration{"a": time.Second, "b": 2 * time.Second}))
+		})
+
+		It("ignores the run times of other suites, which may reuse the same spec IDs", func() {
+			path := filepath.Join(outputDir, "report.json")
+			other := internal.TestSuite{Path: "/path/to/other/a", PackageName: "a", IsGinkgo: true}
+			Ω(reporters.GenerateJSONReport(types.Report{SuitePath: other.AbsPath(), SpecReports: types.SpecReports{
+				it("a", types.SpecStatePassed, time.Minute),
+			}}, path)).Should(Succeed())
+
+			Ω(internal.LoadSpecRunTimes(path, suite)).Should(BeEmpty())
+		})
+
+		It("errors if the report can't be read", func() {
+			_, err := internal.LoadSpecRunTimes(filepath.Join(outputDir, "nope.json"), suite)
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+
+	Describe("UpdateScheduleCache", func() {
+		var suiteA, suiteB internal.TestSuite
+
+		BeforeEach(func() {
+			cliConfig.OutputDir = outputDir
+			suiteA = internal.TestSuite{Path: "/path/to/a", PackageName: "a", IsGinkgo: true}
+			suiteB = internal.TestSuite{Path: "/path/to/b", PackageName: "b", IsGinkgo: true}
+		})
+
+		It("does nothing when --output-dir is not set", func() {
+			cliConfig.OutputDir = ""
+			Ω(internal.UpdateScheduleCache(cliConfig, suiteA, types.SpecReports{it("a", types.SpecStatePassed, time.Second)})).Should(Succeed())
+			Ω(filepath.Join(outputDir, internal.SCHEDULE_CACHE_FILENAME)).ShouldNot(BeAnExistingFile())
+		})
+
+		It("records run times per suite, updating the run times of specs that ran again and keeping the rest", func() {
+			cache := filepath.Join(outputDir, internal.SCHEDULE_CACHE_FILENAME)
+			Ω(internal.UpdateScheduleCache(cliConfig, suiteA, types.SpecReports{it("a1", types.SpecStatePassed, time.Second), it("a2", types.SpecStatePassed, time.Second)})).Should(Succeed())
+			Ω(internal.UpdateScheduleCache(cliConfig, suiteB, types.SpecReports{it("b1", types.SpecStatePassed, 3*time.Second)})).Should(Succeed())
+			Ω(internal.UpdateScheduleCache(cliConfig, suiteA, types.SpecReports{it("a2", types.SpecStateFailed, 4*time.Second), it("a3", types.SpecStatePassed, 5*time.Second)})).Should(Succeed())
+
+			Ω(internal.LoadSpecRunTimes(cache, suiteA)).Should(Equal(map[string]time.Duration{
+				"a1": time.Second,
+				"a2": 4 * time.Second,
+				"a3": 5 * time.Second,
+			}))
+			Ω(internal.LoadSpecRunTimes(cache, suiteB)).Should(Equal(map[string]time.Duration{
+				"b1": 3 * time.Second,
+			}))
+		})
+	})
+})
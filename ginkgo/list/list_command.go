@@ -0,0 +1,167 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/onsi-experimental/ginkgo/v2/formatter"
+	"github.com/onsi-experimental/ginkgo/v2/ginkgo/command"
+	"github.com/onsi-experimental/ginkgo/v2/ginkgo/internal"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+func BuildListCommand() command.Command {
+	var suiteConfig = types.NewDefaultSuiteConfig()
+	var reporterConfig = types.NewDefaultReporterConfig()
+	var cliConfig = types.NewDefaultCLIConfig()
+	var goFlagsConfig = types.NewDefaultGoFlagsConfig()
+
+	flags, err := types.BuildListCommandFlagSet(&suiteConfig, &reporterConfig, &cliConfig, &goFlagsConfig)
+	if err != nil {
+		panic(err)
+	}
+
+	return command.Command{
+		Name:          "list",
+		Usage:         "ginkgo list <FLAGS> <PACKAGES> -- <PASS-THROUGHS>",
+		Flags:         flags,
+		ShortDoc:      "List the specs in the passed-in packages (or the package in the current directory if left blank).",
+		Documentation: "ginkgo list compiles each suite and performs a dry run to build the suite's spec tree.  Each spec is listed with its full text, labels, location, and whether it will run, is skipped, is pending, or is focused.  Any arguments after -- will be passed to the test.",
+		DocLink:       "listing-specs",
+		Command: func(args []string, additionalArgs []string) {
+			var errors []error
+			cliConfig, goFlagsConfig, errors = types.VetAndInitializeCLIAndGoConfig(cliConfig, goFlagsConfig)
+			command.AbortIfErrors("Ginkgo detected configuration issues:", errors)
+
+			ListSpecs(args, additionalArgs, suiteConfig, reporterConfig, cliConfig, goFlagsConfig)
+		},
+	}
+}
+
+// ListedSuite is the JSON representation of a suite emitted by ginkgo list --format=json
+type ListedSuite struct {
+	SuitePath        string
+	SuiteDescription string
+	Specs            []ListedSpec
+}
+
+// ListedSpec is the JSON representation of a spec emitted by ginkgo list --format=json
+type ListedSpec struct {
+	ID       string
+	Text     string
+	Labels   []string
+	Location types.CodeLocation
+	State    string
+	Focused  bool
+}
+
+func ListSpecs(args []string, additionalArgs []string, suiteConfig types.SuiteConfig, reporterConfig types.ReporterConfig, cliConfig types.CLIConfig, goFlagsConfig types.GoFlagsConfig) {
+	suites := internal.FindSuites(args, cliConfig, true).WithoutState(internal.TestSuiteStateSkippedByFilter).ThatAreGinkgoSuites()
+	if len(suites) == 0 {
+		command.AbortWith("Found no test suites")
+	}
+
+	listedSuites := []ListedSuite{}
+	opc := internal.NewOrderedParallelCompiler(cliConfig.ComputedNumCompilers())
+	opc.StartCompiling(suites, goFlagsConfig)
+	for {
+		suiteIdx, suite := opc.Next()
+		if suiteIdx >= len(suites) {
+			break
+		}
+		suites[suiteIdx] = suite
+		if suite.State.Is(internal.TestSuiteStateFailedToCompile) {
+			opc.StopAndDrain()
+			internal.Cleanup(goFlagsConfig, suites...)
+			command.AbortWith(suite.CompilationError.Error())
+		}
+		if suite.State.Is(internal.TestSuiteStateSkippedDueToEmptyCompilation) {
+			continue
+		}
+
+		report, err := internal.DryRunCompiledSuite(suite, suiteConfig, goFlagsConfig, additionalArgs)
+		if err != nil {
+			opc.StopAndDrain()
+			internal.Cleanup(goFlagsConfig, suites...)
+			command.AbortWith(err.Error())
+		}
+		listedSuites = append(listedSuites, listedSuiteFromReport(report))
+	}
+	internal.Cleanup(goFlagsConfig, suites...)
+
+	if strings.ToLower(cliConfig.ListFormat) == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		command.AbortIfError("Failed to encode spec list:", encoder.Encode(listedSuites))
+		return
+	}
+
+	f := formatter.NewWithNoColorBool(reporterConfig.NoColor)
+	for _, listedSuite := range listedSuites {
+		fmt.Fprintln(formatter.ColorableStdOut, f.F("{{bold}}%s{{/}} {{gray}}(%d %s){{/}}", listedSuite.SuiteDescription, len(listedSuite.Specs), internal.PluralizedWord("spec", "specs", len(listedSuite.Specs))))
+		for _, spec := range listedSuite.Specs {
+			fmt.Fprintln(formatter.ColorableStdOut, f.Fi(1, stateTag(spec)+" {{gray}}%s{{/}} %s", spec.ID, spec.Text))
+			if len(spec.Labels) > 0 {
+				fmt.Fprintln(formatter.ColorableStdOut, f.Fi(2, "{{coral}}[%s]{{/}}", strings.Join(spec.Labels, ", ")))
+			}
+			fmt.Fprintln(formatter.ColorableStdOut, f.Fi(2, "{{gray}}%s{{/}}", spec.Location))
+		}
+	}
+}
+
+func listedSuiteFromReport(report types.Report) ListedSuite {
+	listedSuite := ListedSuite{
+		SuitePath:        report.SuitePath,
+		SuiteDescription: report.SuiteDescription,
+		Specs:            []ListedSpec{},
+	}
+	for _, specReport := range report.SpecReports {
+		if !specReport.LeafNodeType.Is(types.NodeTypeIt) {
+			continue
+		}
+		state := "will-run"
+		switch specReport.State {
+		case types.SpecStatePending:
+			state = "pending"
+		case types.SpecStateSkipped:
+			state = "skipped"
+		}
+		listedSuite.Specs = append(listedSuite.Specs, ListedSpec{
+			ID:       specReport.SpecID,
+			Text:     specReport.FullText(),
+			Labels:   specReport.Labels(),
+			Location: specReport.LeafNodeLocation,
+			State:    state,
+			Focused:  specReport.IsFocused,
+		})
+	}
+
+	// the dry run reports specs in the order they would run, we list them in the order they appear in the suite's files
+	sort.SliceStable(listedSuite.Specs, func(i, j int) bool {
+		locA, locB := listedSuite.Specs[i].Location, listedSuite.Specs[j].Location
+		if locA.FileName != locB.FileName {
+			return locA.FileName < locB.FileName
+		}
+		return locA.LineNumber < locB.LineNumber
+	})
+	return listedSuite
+}
+
+func stateTag(spec ListedSpec) string {
+	tag := ""
+	switch spec.State {
+	case "pending":
+		tag = "{{yellow}}[pending]{{/}}"
+	case "skipped":
+		tag = "{{cyan}}[skipped]{{/}}"
+	default:
+		tag = "{{green}}[will run]{{/}}"
+	}
+	if spec.Focused {
+		tag += " {{magenta}}[focused]{{/}}"
+	}
+	return tag
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/onsi-experimental/ginkgo/v2/ginkgo/command"
 	"github.com/onsi-experimental/ginkgo/v2/ginkgo/generators"
 	"github.com/onsi-experimental/ginkgo/v2/ginkgo/labels"
+	"github.com/onsi-experimental/ginkgo/v2/ginkgo/list"
 	"github.com/onsi-experimental/ginkgo/v2/ginkgo/outline"
 	"github.com/onsi-experimental/ginkgo/v2/ginkgo/run"
 	"github.com/onsi-experimental/ginkgo/v2/ginkgo/unfocus"
@@ -24,6 +25,7 @@ func GenerateCommands() []command.Command {
 		generators.BuildBootstrapCommand(),
 		generators.BuildGenerateCommand(),
 		labels.BuildLabelsCommand(),
+		list.BuildListCommand(),
 		outline.BuildOutlineCommand(),
 		unfocus.BuildUnfocusCommand(),
 		BuildVersionCommand(),
@@ -142,28 +142,28 @@ func ginkgoNodeFromCallExpr(fset *token.FileSet, ce *ast.CallExpr, ginkgoPackage
 	n.Start, n.End = absoluteOffsetsForNode(fset, ce)
 	n.Nodes = make([]*ginkgoNode, 0)
 	switch identName {
-	case "It", "Specify", "Entry":
+	case "It", "Specify", "Entry", "EntryOf":
 		n.Spec = true
 		n.Text = textOrAltFromCallExpr(ce, undefinedTextAlt)
 		return &n, ginkgoPackageName != nil && *ginkgoPackageName == packageName
-	case "FIt", "FSpecify", "FEntry":
+	case "FIt", "FSpecify", "FEntry", "FEntryOf":
 		n.Spec = true
 		n.Focused = true
 		n.Text = textOrAltFromCallExpr(ce, undefinedTextAlt)
 		return &n, ginkgoPackageName != nil && *ginkgoPackageName == packageName
-	case "PIt", "PSpecify", "XIt", "XSpecify", "PEntry", "XEntry":
+	case "PIt", "PSpecify", "XIt", "XSpecify", "PEntry", "XEntry", "PEntryOf", "XEntryOf":
 		n.Spec = true
 		n.Pending = true
 		n.Text = textOrAltFromCallExpr(ce, undefinedTextAlt)
 		return &n, ginkgoPackageName != nil && *ginkgoPackageName == packageName
-	case "Context", "Describe", "When", "DescribeTable":
+	case "Context", "Describe", "When", "DescribeTable", "DescribeTableSubtree", "DescribeTableOf":
 		n.Text = textOrAltFromCallExpr(ce, undefinedTextAlt)
 		return &n, ginkgoPackageName != nil && *ginkgoPackageName == packageName
-	case "FContext", "FDescribe", "FWhen", "FDescribeTable":
+	case "FContext", "FDescribe", "FWhen", "FDescribeTable", "FDescribeTableSubtree", "FDescribeTableOf":
 		n.Focused = true
 		n.Text = textOrAltFromCallExpr(ce, undefinedTextAlt)
 		return &n, ginkgoPackageName != nil && *ginkgoPackageName == packageName
-	case "PContext", "PDescribe", "PWhen", "XContext", "XDescribe", "XWhen", "PDescribeTable", "XDescribeTable":
+	case "PContext", "PDescribe", "PWhen", "XContext", "XDescribe", "XWhen", "PDescribeTable", "XDescribeTable", "PDescribeTableSubtree", "XDescribeTableSubtree", "PDescribeTableOf", "XDescribeTableOf":
 		n.Pending = true
 		n.Text = textOrAltFromCallExpr(ce, undefinedTextAlt)
 		return &n, ginkgoPackageName != nil && *ginkgoPackageName == packageName
@@ -37,6 +37,7 @@ func BuildRunCommand() command.Command {
 		Command: func(args []string, additionalArgs []string) {
 			var errors []error
 			cliConfig, goFlagsConfig, errors = types.VetAndInitializeCLIAndGoConfig(cliConfig, goFlagsConfig)
+			errors = append(errors, types.VetShardConfig(flags, suiteConfig)...)
 			command.AbortIfErrors("Ginkgo detected configuration issues:", errors)
 
 			runner := &SpecRunner{
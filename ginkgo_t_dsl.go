@@ -1,6 +1,10 @@
 package ginkgo
 
-import "github.com/onsi-experimental/ginkgo/v2/internal/testingtproxy"
+import (
+	"testing"
+
+	"github.com/onsi-experimental/ginkgo/v2/internal/testingtproxy"
+)
 
 /*
 GinkgoT() implements an interface analogous to *testing.T and can be used with
@@ -19,6 +23,26 @@ func GinkgoT(optionalOffset ...int) GinkgoTInterface {
 	return testingtproxy.New(GinkgoWriter, Fail, Skip, DeferCleanup, CurrentSpecReport, offset)
 }
 
+/*
+GinkgoTB() returns a testing.TB and can be passed to third-party libraries that are typed against testing.TB
+(GinkgoT() cannot be used with such libraries as testing.TB includes an unexported method).
+
+The returned value embeds the *testing.T passed in to RunSpecs.  Fail, Error, Fatal, Skip and friends are forwarded to Ginkgo's Fail and Skip,
+Log and Logf are written to the GinkgoWriter, and Cleanup, TempDir and Setenv are scoped to the current spec via DeferCleanup.  Any other
+testing.TB methods are forwarded to the embedded *testing.T.  If RunSpecs was not passed a testing.TB these methods will panic.
+
+Like GinkgoT(), GinkgoTB() takes an optional offset argument that can be used to get the correct line number associated with the failure.
+
+You can learn more here: https://onsi.github.io/ginkgo/#using-third-party-libraries
+*/
+func GinkgoTB(optionalOffset ...int) testing.TB {
+	offset := 3
+	if len(optionalOffset) > 0 {
+		offset = optionalOffset[0]
+	}
+	return testingtproxy.NewTB(testingTB, GinkgoWriter, Fail, Skip, DeferCleanup, CurrentSpecReport, offset)
+}
+
 /*
 The interface returned by GinkgoT().  This covers most of the methods in the testing package's T.
 */
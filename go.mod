@@ -1,11 +1,20 @@
 module github.com/onsi-experimental/ginkgo/v2
 
-go 1.16
+go 1.18
 
 require (
+	github.com/go-logr/logr v1.2.3
 	github.com/go-task/slim-sprig v0.0.0-20210107165309-348f09dbbbc0
 	github.com/google/pprof v0.0.0-20210407192527-94a9f03dee38
 	github.com/onsi/gomega v1.17.0
 	golang.org/x/sys v0.0.0-20210423082822-04245dca01da
 	golang.org/x/tools v0.0.0-20201224043029-2b0845dc783e
-)
\ No newline at end of file
+)
+
+require (
+	github.com/golang/protobuf v1.5.2 // indirect
+	golang.org/x/net v0.0.0-20210428140749-89ef3d95e781 // indirect
+	golang.org/x/text v0.3.6 // indirect
+	google.golang.org/protobuf v1.26.0 // indirect
+	gopkg.in/yaml.v2 v2.4.0 // indirect
+)
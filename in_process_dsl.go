@@ -0,0 +1,174 @@
+package ginkgo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onsi-experimental/ginkgo/v2/internal"
+	"github.com/onsi-experimental/ginkgo/v2/internal/global"
+	"github.com/onsi-experimental/ginkgo/v2/internal/interrupt_handler"
+	"github.com/onsi-experimental/ginkgo/v2/reporters"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+/*
+RunSuiteInProcess builds and runs the spec tree defined by tree in-process and returns the resulting suite Report.
+
+RunSuiteInProcess is intended for meta-testing tooling built on top of Ginkgo (e.g. custom DSL helpers and decorators) without shelling out to the ginkgo CLI.  For example:
+
+	report, err := RunSuiteInProcess("my helpers", func() {
+		Describe("a container", func() {
+			MyCustomHelper("does something")
+		})
+	}, types.NewDefaultSuiteConfig(), types.NewDefaultReporterConfig())
+	Ω(err).ShouldNot(HaveOccurred())
+	Ω(report.SpecReports).Should(HaveLen(1))
+
+The tree closure is called to register top-level nodes using Ginkgo's package-level DSL.  The resulting suite is isolated from the global suite (and from any
+other suite that is currently running): it has its own failer, GinkgoWriter, and configuration and does not intercept output or listen for interrupt signals.  The
+suite is interrupted if suiteConf.Timeout elapses.
+
+reporterConf configures the console output generated by the suite, which is emitted to the caller's GinkgoWriter.  Any machine-readable reports configured in reporterConf are generated as usual.
+
+Errors that would normally cause Ginkgo to exit - such as an invalid spec tree or invalid configuration - are returned instead.  In-process suites cannot run in parallel.
+
+You can learn more about RunSuiteInProcess here: https://onsi.github.io/ginkgo/#running-suites-in-process
+*/
+func RunSuiteInProcess(description string, tree func(), suiteConf types.SuiteConfig, reporterConf types.ReporterConfig) (types.Report, error) {
+	if suiteConf.ParallelTotal > 1 {
+		return types.Report{}, types.GinkgoErrors.InProcessSuiteInParallelConfiguration()
+	}
+	suiteConf.ParallelTotal, suiteConf.ParallelProcess = 1, 1
+	configErrors := types.VetConfig(flagSet, suiteConf, reporterConf)
+	if suiteConf.ComputedParallelGoroutines() > 1 && !internal.RuntimeReportsGoroutineLineage() {
+		configErrors = append(configErrors, types.GinkgoErrors.GoroutineParallelModeUnsupportedByRuntime(runtime.Version()))
+	}
+	if len(configErrors) > 0 {
+		return types.Report{}, combinedInProcessErrors(configErrors)
+	}
+
+	suitePath, err := os.Getwd()
+	if err != nil {
+		return types.Report{}, err
+	}
+	suitePath, err = filepath.Abs(suitePath)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	suite, failer := internal.NewSuite(), internal.NewFailer()
+	writer := internal.NewWriter(GinkgoWriter)
+	if reporterConf.Verbose && suiteConf.ComputedParallelGoroutines() == 1 {
+		writer.SetMode(internal.WriterModeStreamAndBuffer)
+	} else {
+		writer.SetMode(internal.WriterModeBufferOnly)
+	}
+	reporter := &inProcessReporter{Reporter: reporters.NewDefaultReporter(reporterConf, GinkgoWriter)}
+
+	previousSuite, previousFailer, previousWriter := global.Suite, global.Failer, GinkgoWriter
+	previousSuiteConfig, previousReporterConfig := suiteConfig, reporterConfig
+	global.Suite, global.Failer, GinkgoWriter = suite, failer, writer
+	suiteConfig, reporterConfig = suiteConf, reporterConf
+	treeConstructionErrors := []error{}
+	inProcessTreeConstructionErrors = &treeConstructionErrors
+	defer func() {
+		global.Suite, global.Failer, GinkgoWriter = previousSuite, previousFailer, previousWriter
+		suiteConfig, reporterConfig = previousSuiteConfig, previousReporterConfig
+		inProcessTreeConstructionErrors = nil
+	}()
+
+	tree()
+	if reporterConf.WillGenerateReport() {
+		registerReportAfterSuiteNodeForAutogeneratedReports(reporterConf)
+	}
+	exitIfErr(suite.BuildTree())
+	inProcessTreeConstructionErrors = nil
+	if len(treeConstructionErrors) > 0 {
+		return types.Report{}, combinedInProcessErrors(treeConstructionErrors)
+	}
+
+	interruptHandler := newInProcessInterruptHandler(suiteConf.Timeout)
+	defer interruptHandler.Stop()
+	noopProgressSignalRegistrar := func(func()) context.CancelFunc { return func() {} }
+	suite.Run(description, suitePath, failer, reporter, writer, internal.NoopOutputInterceptor{}, interruptHandler, nil, noopProgressSignalRegistrar, suiteConf)
+
+	return reporter.report, nil
+}
+
+func combinedInProcessErrors(errors []error) error {
+	if len(errors) == 1 {
+		return errors[0]
+	}
+	messages := []string{}
+	for _, err := range errors {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "\n"))
+}
+
+// inProcessReporter forwards to Ginkgo's console reporter and holds on to the final suite report
+type inProcessReporter struct {
+	reporters.Reporter
+	report types.Report
+}
+
+func (r *inProcessReporter) SuiteDidEnd(report types.Report) {
+	r.report = report
+	r.Reporter.SuiteDidEnd(report)
+}
+
+// inProcessInterruptHandler does not listen for signals and only interrupts the suite if its timeout elapses
+type inProcessInterruptHandler struct {
+	lock        *sync.Mutex
+	c           chan interface{}
+	interrupted bool
+	timer       *time.Timer
+}
+
+func newInProcessInterruptHandler(timeout time.Duration) *inProcessInterruptHandler {
+	handler := &inProcessInterruptHandler{
+		lock: &sync.Mutex{},
+		c:    make(chan interface{}),
+	}
+	if timeout > 0 {
+		handler.timer = time.AfterFunc(timeout, func() {
+			handler.lock.Lock()
+			defer handler.lock.Unlock()
+			handler.interrupted = true
+			close(handler.c)
+			handler.c = make(chan interface{})
+		})
+	}
+	return handler
+}
+
+func (handler *inProcessInterruptHandler) Stop() {
+	if handler.timer != nil {
+		handler.timer.Stop()
+	}
+}
+
+func (handler *inProcessInterruptHandler) Status() interrupt_handler.InterruptStatus {
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+	status := interrupt_handler.InterruptStatus{
+		Interrupted: handler.interrupted,
+		Channel:     handler.c,
+	}
+	if handler.interrupted {
+		status.Cause = interrupt_handler.InterruptCauseTimeout
+	}
+	return status
+}
+
+func (handler *inProcessInterruptHandler) SetInterruptPlaceholderMessage(string) {}
+func (handler *inProcessInterruptHandler) ClearInterruptPlaceholderMessage()     {}
+func (handler *inProcessInterruptHandler) InterruptMessageWithStackTraces() string {
+	return handler.Status().Cause.String()
+}
@@ -0,0 +1,65 @@
+package exclusive_fixture_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var noExclusive *bool
+
+func init() {
+	noExclusive = flag.CommandLine.Bool("no-exclusive", false, "set to turn off exclusive decoration")
+}
+
+var ExclusiveDecoration = []interface{}{Exclusive("db")}
+
+func TestExclusiveFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	if *noExclusive {
+		ExclusiveDecoration = []interface{}{}
+	}
+
+	RunSpecs(t, "ExclusiveFixture Suite")
+}
+
+var dir string
+
+var _ = SynchronizedBeforeSuite(func() []byte {
+	dir, err := os.MkdirTemp("", "exclusive_fixture")
+	Ω(err).ShouldNot(HaveOccurred())
+	return []byte(dir)
+}, func(data []byte) {
+	dir = string(data)
+})
+
+var _ = SynchronizedAfterSuite(func() {}, func() {
+	Ω(os.RemoveAll(dir)).Should(Succeed())
+})
+
+// useDB fails if another process is using the db at the same time
+var useDB = func() {
+	marker := filepath.Join(dir, "db")
+	f, err := os.OpenFile(marker, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0666)
+	Ω(err).ShouldNot(HaveOccurred(), "another spec is using the db")
+	Ω(f.Close()).Should(Succeed())
+	time.Sleep(100 * time.Millisecond)
+	Ω(os.Remove(marker)).Should(Succeed())
+}
+
+var _ = Describe("tests", func() {
+	for i := 0; i < 9; i += 1 {
+		It("uses the db", ExclusiveDecoration, useDB)
+	}
+
+	for i := 0; i < 3; i += 1 {
+		It("runs alongside the db specs", func() {
+			time.Sleep(50 * time.Millisecond)
+		})
+	}
+})
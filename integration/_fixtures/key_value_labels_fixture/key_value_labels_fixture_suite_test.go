@@ -0,0 +1,13 @@
+package key_value_labels_fixture_test
+
+import (
+	"testing"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestKeyValueLabelsFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "KeyValueLabelsFixture Suite")
+}
@@ -0,0 +1,15 @@
+package key_value_labels_fixture_test
+
+import (
+	. "github.com/onsi-experimental/ginkgo/v2"
+)
+
+var _ = Describe("KeyValueLabelsFixture", Label("owner : payments"), func() {
+	It("is high priority", Label("priority:2", "smoke"), func() {
+
+	})
+
+	It("is low priority", Label("priority:1"), func() {
+
+	})
+})
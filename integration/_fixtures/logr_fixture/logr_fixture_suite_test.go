@@ -0,0 +1,27 @@
+package logr_fixture_test
+
+import (
+	"testing"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLogrFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "LogrFixture Suite")
+}
+
+var _ = Describe("GinkgoLogr", func() {
+	It("passes", func() {
+		GinkgoLogr.Info("passing-info", "spec", "passes")
+	})
+
+	It("fails", func() {
+		GinkgoLogr.WithName("fixture").Info("failing-info", "spec", "fails")
+		GinkgoLogr.V(1).Info("failing-v1")
+		GinkgoLogr.V(2).Info("failing-v2")
+		GinkgoLogrWithVerbosity(2).V(2).Info("fixed-v2")
+		Fail("boom")
+	})
+})
@@ -0,0 +1,63 @@
+package multiple_suites_fixture_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var unitSuite = NewSuite()
+var slowSuite = NewSuite()
+
+func TestUnit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	unitSuite.RunSpecs(t, "Unit Suite")
+}
+
+func TestSlow(t *testing.T) {
+	RegisterFailHandler(Fail)
+	suiteConfig, reporterConfig := GinkgoConfiguration()
+	suiteConfig.LabelFilter = "!NORUN"
+	slowSuite.RunSpecs(t, "Slow Suite", suiteConfig, reporterConfig)
+}
+
+var _ = unitSuite.BeforeSuite(func() {
+	fmt.Println("UNIT BEFORE SUITE")
+})
+
+var _ = unitSuite.Describe("unit", func() {
+	It("runs in the unit suite", func() {
+		Ω(CurrentSpecReport().FullText()).Should(Equal("unit runs in the unit suite"))
+	})
+
+	It("is not affected by the slow suite's configuration", Label("NORUN"), func() {
+		suiteConfig, _ := GinkgoConfiguration()
+		Ω(suiteConfig.LabelFilter).Should(BeEmpty())
+	})
+})
+
+var _ = slowSuite.BeforeSuite(func() {
+	fmt.Println("SLOW BEFORE SUITE")
+})
+
+var _ = slowSuite.Describe("slow", func() {
+	BeforeEach(func() {
+		DeferCleanup(func() {
+			fmt.Println("SLOW CLEANUP")
+		})
+	})
+
+	It("runs in the slow suite", func() {
+		Ω(CurrentSpecReport().FullText()).Should(Equal("slow runs in the slow suite"))
+	})
+
+	It("never runs", Label("NORUN"), func() {
+		Fail("should not run")
+	})
+})
+
+var _ = It("is registered with the default suite and never runs", func() {
+	Fail("should not run")
+})
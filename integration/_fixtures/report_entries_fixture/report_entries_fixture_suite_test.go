@@ -58,6 +58,13 @@ var _ = Describe("top-level container", func() {
 		})
 	})
 
+	It("fails during a By step", func() {
+		By("an outer step", func() {
+			By("an inner step")
+			Fail("boom")
+		})
+	})
+
 	AfterEach(func() {
 		s.Label = CurrentSpecReport().State.String()
 		s.Count = 4
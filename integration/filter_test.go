@@ -107,5 +107,12 @@ var _ = Describe("Filter", func() {
 			Ω(session).Should(gbytes.Say(`nolabels: No labels found`))
 			Ω(session).Should(gbytes.Say(`onepkg: \["beluga", "bird", "cat", "chicken", "cow", "dog", "giraffe", "koala", "monkey", "otter", "owl", "panda"\]`))
 		})
+
+		It("lists key/value labels in their cleaned-up form", func() {
+			fm.MountFixture("key_value_labels")
+			session := startGinkgo(fm.PathTo("key_value_labels"), "labels")
+			Eventually(session).Should(gexec.Exit(0))
+			Ω(session).Should(gbytes.Say(`key_value_labels: \["owner:payments", "priority:1", "priority:2", "smoke"\]`))
+		})
 	})
 })
@@ -1,9 +1,11 @@
 package integration_test
 
 import (
+	"os/exec"
 	"strings"
 
 	. "github.com/onsi-experimental/ginkgo/v2"
+	"github.com/onsi-experimental/ginkgo/v2/reporters"
 	"github.com/onsi-experimental/ginkgo/v2/types"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gexec"
@@ -145,6 +147,68 @@ var _ = Describe("Flags Specs", func() {
 		Ω(output).Should(ContainSubstring("0 Failed"))
 	})
 
+	It("should perform a dry run in a single process when running in parallel", func() {
+		fm.MountFixture("fail")
+		session := startGinkgo(fm.PathTo("fail"), "--dry-run", "--seed=17", "--json-report=serial.json")
+		Eventually(session).Should(gexec.Exit(0))
+		session = startGinkgo(fm.PathTo("fail"), "--dry-run", "-p", "--seed=17", "--json-report=parallel.json")
+		Eventually(session).Should(gexec.Exit(0))
+		Ω(string(session.Out.Contents())).Should(ContainSubstring("6 Passed"))
+
+		serialReport := fm.LoadJSONReports("fail", "serial.json")[0]
+		parallelReport := fm.LoadJSONReports("fail", "parallel.json")[0]
+		Ω(parallelReport.SuiteConfig.ParallelTotal).Should(Equal(1))
+		Ω(parallelReport.SpecReports).Should(HaveLen(len(serialReport.SpecReports)))
+		for i := range serialReport.SpecReports {
+			Ω(parallelReport.SpecReports[i].FullText()).Should(Equal(serialReport.SpecReports[i].FullText()))
+			Ω(parallelReport.SpecReports[i].SpecID).Should(Equal(serialReport.SpecReports[i].SpecID))
+			Ω(parallelReport.SpecReports[i].State).Should(Equal(serialReport.SpecReports[i].State))
+		}
+	})
+
+	Describe("sharding specs", func() {
+		BeforeEach(func() {
+			fm.MountFixture("ordered")
+		})
+
+		It("requires an explicit seed", func() {
+			session := startGinkgo(fm.PathTo("ordered"), "--shard-index=1", "--shard-total=2")
+			Eventually(session).Should(gexec.Exit(1))
+			Ω(session.Err.Contents()).Should(ContainSubstring("Sharding requires an explicit --seed."))
+		})
+
+		It("requires an explicit seed when the suite is run with go test", func() {
+			cmd := exec.Command("go", "test", "-ginkgo.shard-index=1", "-ginkgo.shard-total=2")
+			cmd.Dir = fm.PathTo("ordered")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Ω(err).ShouldNot(HaveOccurred())
+			Eventually(session).Should(gexec.Exit(1))
+			Ω(session.Out.Contents()).Should(ContainSubstring("Sharding requires an explicit --seed."))
+		})
+
+		It("runs each spec on exactly one shard and generates reports that can be merged", func() {
+			for _, shard := range []string{"1", "2"} {
+				session := startGinkgo(fm.PathTo("ordered"), "-p", "--seed=17", "--shard-index="+shard, "--shard-total=2", "--json-report=shard-"+shard+".json")
+				Eventually(session).Should(gexec.Exit(0))
+			}
+
+			shard1 := fm.LoadJSONReports("ordered", "shard-1.json")[0]
+			shard2 := fm.LoadJSONReports("ordered", "shard-2.json")[0]
+			total := shard1.PreRunStats.TotalSpecs
+			Ω(shard1.PreRunStats.SpecsThatWillRun).Should(BeNumerically(">", 0))
+			Ω(shard2.PreRunStats.SpecsThatWillRun).Should(BeNumerically(">", 0))
+			Ω(shard1.PreRunStats.SpecsThatWillRun + shard2.PreRunStats.SpecsThatWillRun).Should(Equal(total))
+
+			Ω(reporters.MergeShardedJSONReports([]string{fm.PathTo("ordered", "shard-1.json"), fm.PathTo("ordered", "shard-2.json")}, fm.PathTo("ordered", "merged.json"))).Should(Succeed())
+			merged := fm.LoadJSONReports("ordered", "merged.json")
+			Ω(merged).Should(HaveLen(1))
+			Ω(merged[0].SuiteSucceeded).Should(BeTrue())
+			specs := merged[0].SpecReports.WithLeafNodeType(types.NodeTypeIt)
+			Ω(specs).Should(HaveLen(total))
+			Ω(specs.CountWithState(types.SpecStatePassed)).Should(Equal(total))
+		})
+	})
+
 	It("should honor compiler flags", func() {
 		session := startGinkgo(fm.PathTo("flags"), "-gcflags=-importmap 'math=math/cmplx'")
 		Eventually(session).Should(gexec.Exit(types.GINKGO_FOCUS_EXIT_CODE))
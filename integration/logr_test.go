@@ -0,0 +1,41 @@
+package integration_test
+
+import (
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("GinkgoLogr", func() {
+	BeforeEach(func() {
+		fm.MountFixture("logr")
+	})
+
+	It("writes structured lines to the GinkgoWriter of the running spec", func() {
+		session := startGinkgo(fm.PathTo("logr"), "--no-color", "--json-report=out.json")
+		Eventually(session).Should(gexec.Exit(1))
+		output := string(session.Out.Contents())
+
+		Ω(output).Should(ContainSubstring(`fixture: "level"=0 "msg"="failing-info" "spec"="fails"`))
+		Ω(output).Should(ContainSubstring(`"level"=2 "msg"="fixed-v2"`))
+		Ω(output).ShouldNot(ContainSubstring("failing-v1"))
+		Ω(output).ShouldNot(ContainSubstring("failing-v2"))
+		Ω(output).ShouldNot(ContainSubstring("passing-info"))
+
+		reports := Reports(fm.LoadJSONReports("logr", "out.json")[0].SpecReports)
+		Ω(reports.Find("passes").CapturedGinkgoWriterOutput).Should(Equal(`"level"=0 "msg"="passing-info" "spec"="passes"` + "\n"))
+		Ω(reports.Find("fails").CapturedGinkgoWriterOutput).Should(ContainSubstring("failing-info"))
+	})
+
+	It("streams lines in verbose mode and honors --ginkgo-logr-verbosity", func() {
+		session := startGinkgo(fm.PathTo("logr"), "--no-color", "-v", "--ginkgo-logr-verbosity=1")
+		Eventually(session).Should(gexec.Exit(1))
+		output := string(session.Out.Contents())
+
+		Ω(output).Should(ContainSubstring(`"level"=0 "msg"="passing-info" "spec"="passes"`))
+		Ω(output).Should(ContainSubstring(`"level"=1 "msg"="failing-v1"`))
+		Ω(output).ShouldNot(ContainSubstring("failing-v2"))
+		Ω(output).Should(ContainSubstring("fixed-v2"))
+	})
+})
@@ -47,6 +47,11 @@ var _ = Describe("ReportEntries", func() {
 			Ω(output).ShouldNot(ContainSubstring("fails-never-see-report"))
 
 			Ω(output).ShouldNot(ContainSubstring("registers a hidden AddReportEntry"))
+
+			Ω(output).Should(ContainSubstring("Begin Step Timeline"))
+			Ω(output).Should(MatchRegexp(`STEP: an outer step \[\d+\.\d+ seconds\] - .*report_entries_fixture_suite_test\.go:\d+`))
+			Ω(output).Should(MatchRegexp(`  STEP: an inner step \[\d+\.\d+ seconds\] - .*report_entries_fixture_suite_test\.go:\d+`))
+			Ω(output).Should(MatchRegexp(`During step an inner step at: .*report_entries_fixture_suite_test\.go:\d+`))
 		})
 
 		It("captures all report entries in the JSON report", func() {
@@ -115,6 +120,21 @@ var _ = Describe("ReportEntries", func() {
 			value = by.ReportEntries[1].GetRawValue().(map[string]interface{})
 			Ω(value["Text"]).Should(Equal("includes durations"))
 			Ω(time.Duration(value["Duration"].(float64))).Should(BeNumerically("~", time.Millisecond*100, time.Millisecond*100))
+
+			Ω(by.Steps).Should(HaveLen(2))
+			Ω(by.Steps[0].Text).Should(Equal("registers a hidden AddReportEntry"))
+			Ω(by.Steps[0].NestingLevel).Should(Equal(0))
+			Ω(by.Steps[0].StartTime).Should(BeTemporally("~", time.Now(), time.Minute))
+			Ω(by.Steps[1].Text).Should(Equal("includes durations"))
+			Ω(by.Steps[1].CodeLocation.FileName).Should(HaveSuffix("report_entries_fixture_suite_test.go"))
+			Ω(by.Steps[1].Duration).Should(BeNumerically("~", time.Millisecond*100, time.Millisecond*100))
+
+			failsDuringStep := reports.Find("fails during a By step")
+			Ω(failsDuringStep.Steps).Should(HaveLen(2))
+			Ω(failsDuringStep.Steps[1].Text).Should(Equal("an inner step"))
+			Ω(failsDuringStep.Steps[1].NestingLevel).Should(Equal(1))
+			Ω(failsDuringStep.Failure.FailureStepText).Should(Equal("an inner step"))
+			Ω(failsDuringStep.Failure.FailureStepLocation).Should(Equal(failsDuringStep.Steps[1].CodeLocation))
 		})
 
 		It("captures all report entries in the JUnit report", func() {
@@ -147,6 +167,22 @@ var _ = Describe("ReportEntries", func() {
 			Ω(buf).Should(gbytes.Say("6"))
 			Ω(buf).ShouldNot(gbytes.Say("--"))
 		})
+
+		It("captures the step timeline in the JUnit report", func() {
+			var content string
+			for _, testCase := range junit.TestCases {
+				if testCase.Name == "[It] top-level container fails during a By step" {
+					content = testCase.SystemOut
+				}
+			}
+
+			buf := gbytes.BufferWithBytes([]byte(content))
+
+			Ω(buf).Should(gbytes.Say("Step Timeline:"))
+			Ω(buf).Should(gbytes.Say(`\nSTEP: an outer step \[.*\] - .*report_entries_fixture_suite_test\.go:\d+`))
+			Ω(buf).Should(gbytes.Say(`\n  STEP: an inner step \[.*\] - .*report_entries_fixture_suite_test\.go:\d+`))
+			Ω(buf).Should(gbytes.Say("Failed during step: an inner step"))
+		})
 	})
 
 	Describe("when running in verbose mode", func() {
@@ -127,6 +127,8 @@ var _ = Describe("Reporting", func() {
 			Ω(specReports.Find("passes")).Should(HavePassed())
 			Ω(specReports.Find("is labelled")).Should(HavePassed())
 			Ω(specReports.Find("is labelled").Labels()).Should(Equal([]string{"dog", "cat"}))
+			Ω(specReports.Find("passes").SpecID).ShouldNot(BeEmpty())
+			Ω(specReports.FindByLeafNodeType(types.NodeTypeBeforeSuite).SpecID).Should(BeEmpty())
 			Ω(specReports.Find("fails")).Should(HaveFailed("fail!", types.FailureNodeIsLeafNode, CapturedGinkgoWriterOutput("some ginkgo-writer output")))
 			Ω(specReports.Find("panics")).Should(HavePanicked("boom"))
 			Ω(specReports.Find("is pending")).Should(BePending())
@@ -180,6 +182,8 @@ var _ = Describe("Reporting", func() {
 			Ω(getTestCase("[BeforeSuite]", suite.TestCases).Status).Should(Equal("passed"))
 			Ω(getTestCase("[It] reporting test passes", suite.TestCases).Classname).Should(Equal("ReportingFixture Suite"))
 			Ω(getTestCase("[It] reporting test passes", suite.TestCases).Status).Should(Equal("passed"))
+			Ω(getTestCase("[It] reporting test passes", suite.TestCases).ID).ShouldNot(BeEmpty())
+			Ω(getTestCase("[BeforeSuite]", suite.TestCases).ID).Should(BeEmpty())
 			Ω(getTestCase("[It] reporting test passes", suite.TestCases).Failure).Should(BeNil())
 			Ω(getTestCase("[It] reporting test passes", suite.TestCases).Error).Should(BeNil())
 			Ω(getTestCase("[It] reporting test passes", suite.TestCases).Skipped).Should(BeNil())
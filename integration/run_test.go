@@ -289,6 +289,52 @@ var _ = Describe("Running Specs", func() {
 				Ω(output).Should(ContainSubstring("Test Suite Passed"))
 			})
 		})
+
+		Context("when scheduling from previous run times", func() {
+			It("caches the run times in -output-dir and uses them on the next run", func() {
+				session := startGinkgo(fm.PathTo("passing_ginkgo_tests"), "--no-color", "--procs=2", "--output-dir=./out")
+				Eventually(session).Should(gexec.Exit(0))
+
+				cache := fm.LoadJSONReports("passing_ginkgo_tests", "out/ginkgo-schedule.json")
+				Ω(cache).Should(HaveLen(1))
+				Ω(cache[0].SuitePath).Should(Equal(fm.AbsPathTo("passing_ginkgo_tests")))
+				Ω(cache[0].SpecReports).Should(HaveLen(4))
+				for _, specReport := range cache[0].SpecReports {
+					Ω(specReport.SpecID).ShouldNot(BeEmpty())
+					Ω(specReport.State).Should(Equal(types.SpecStatePassed))
+				}
+
+				session = startGinkgo(fm.PathTo("passing_ginkgo_tests"), "--no-color", "--procs=2", "--output-dir=./out")
+				Eventually(session).Should(gexec.Exit(0))
+				Ω(fm.LoadJSONReports("passing_ginkgo_tests", "out/ginkgo-schedule.json")[0].SpecReports).Should(HaveLen(4))
+			})
+
+			It("schedules from the report passed to --schedule-from", func() {
+				session := startGinkgo(fm.PathTo("passing_ginkgo_tests"), "--no-color", "--json-report=report.json")
+				Eventually(session).Should(gexec.Exit(0))
+
+				session = startGinkgo(fm.PathTo("passing_ginkgo_tests"), "--no-color", "--procs=2", "--schedule-from=report.json")
+				Eventually(session).Should(gexec.Exit(0))
+				Ω(session).Should(gbytes.Say("Test Suite Passed"))
+			})
+
+			It("warns and runs the specs anyway if the --schedule-from report can't be loaded", func() {
+				session := startGinkgo(fm.PathTo("passing_ginkgo_tests"), "--no-color", "--procs=2", "--schedule-from=nope.json")
+				Eventually(session).Should(gexec.Exit(0))
+				Ω(session.Err).Should(gbytes.Say("Failed to load spec run times from nope.json"))
+				Ω(session).Should(gbytes.Say("Test Suite Passed"))
+			})
+
+			It("warns and keeps the run's results if the schedule cache can't be updated", func() {
+				Ω(os.MkdirAll(fm.PathTo("passing_ginkgo_tests", "out", "ginkgo-schedule.json"), 0777)).Should(Succeed())
+				session := startGinkgo(fm.PathTo("passing_ginkgo_tests"), "--no-color", "--procs=2", "--output-dir=./out", "--json-report=report.json")
+				Eventually(session).Should(gexec.Exit(0))
+				Ω(session.Err).Should(gbytes.Say("Failed to load spec run times from"))
+				Ω(session.Err).Should(gbytes.Say("Failed to update the schedule cache"))
+				Ω(session).Should(gbytes.Say("Test Suite Passed"))
+				Ω(fm.PathTo("passing_ginkgo_tests", "out", "report.json")).Should(BeAnExistingFile())
+			})
+		})
 	})
 
 	Context("when running in parallel and there are specs marked Serial", Label("slow"), func() {
@@ -307,6 +353,23 @@ var _ = Describe("Running Specs", func() {
 		})
 	})
 
+	Context("when running in parallel and there are specs marked Exclusive", Label("slow"), func() {
+		BeforeEach(func() {
+			fm.MountFixture("exclusive")
+		})
+
+		It("never runs specs that share a key at the same time", func() {
+			By("running a carefully crafted test without the exclusive decorator")
+			session := startGinkgo(fm.PathTo("exclusive"), "--no-color", "--procs=3", "--randomize-all", "--fail-fast", "--", "--no-exclusive")
+			Eventually(session).Should(gexec.Exit(1))
+
+			By("running a carefully crafted test with the exclusive decorator")
+			session = startGinkgo(fm.PathTo("exclusive"), "--no-color", "--procs=3", "--randomize-all", "--fail-fast")
+			Eventually(session).Should(gexec.Exit(0))
+			Ω(session).Should(gbytes.Say("Test Suite Passed"))
+		})
+	})
+
 	Context("when running with ordered specs", func() {
 		BeforeEach(func() {
 			fm.MountFixture("ordered")
@@ -421,6 +484,35 @@ var _ = Describe("Running Specs", func() {
 		})
 	})
 
+	Context("when a package hosts multiple suites", func() {
+		BeforeEach(func() {
+			fm.MountFixture("multiple_suites")
+		})
+
+		It("runs each suite independently, with its own configuration", func() {
+			session := startGinkgo(fm.PathTo("multiple_suites"), "--no-color", "-v")
+			Eventually(session).Should(gexec.Exit(0))
+			output := string(session.Out.Contents())
+
+			Ω(output).Should(ContainSubstring("Running Suite: Unit Suite"))
+			Ω(output).Should(ContainSubstring("UNIT BEFORE SUITE"))
+			Ω(output).Should(ContainSubstring("Ran 2 of 2 Specs"))
+
+			Ω(output).Should(ContainSubstring("Running Suite: Slow Suite"))
+			Ω(output).Should(ContainSubstring("SLOW BEFORE SUITE"))
+			Ω(output).Should(ContainSubstring("SLOW CLEANUP"))
+			Ω(output).Should(ContainSubstring("Ran 1 of 2 Specs"))
+
+			Ω(output).ShouldNot(ContainSubstring("never runs"))
+		})
+
+		It("refuses to run more than one suite in parallel", func() {
+			session := startGinkgo(fm.PathTo("multiple_suites"), "--no-color", "--procs=2")
+			Eventually(session).Should(gexec.Exit(1))
+			Ω(session.Err).Should(gbytes.Say("Multiple Suites in Parallel"))
+		})
+	})
+
 	Context("when running large suites in parallel", Label("slow"), func() {
 		BeforeEach(func() {
 			fm.MountFixture("large")
@@ -1,10 +1,12 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"os"
 	"strings"
 
 	. "github.com/onsi-experimental/ginkgo/v2"
+	"github.com/onsi-experimental/ginkgo/v2/ginkgo/list"
 	"github.com/onsi-experimental/ginkgo/v2/types"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gexec"
@@ -481,6 +483,43 @@ var _ = Describe("Subcommand", func() {
 		})
 	})
 
+	Describe("ginkgo list", func() {
+		It("lists the specs in the suite as JSON, honoring filters", func() {
+			fm.MountFixture("filter")
+			session := startGinkgo(fm.PathTo("filter"), "list", "--format=json", "--label-filter=slow")
+			Eventually(session).Should(gexec.Exit(0))
+
+			listedSuites := []list.ListedSuite{}
+			Ω(json.Unmarshal(session.Out.Contents(), &listedSuites)).Should(Succeed())
+			Ω(listedSuites).Should(HaveLen(1))
+			Ω(listedSuites[0].SuiteDescription).Should(Equal("FilterFixture Suite"))
+			Ω(listedSuites[0].Specs).Should(HaveLen(37))
+
+			specs := map[string]list.ListedSpec{}
+			for _, spec := range listedSuites[0].Specs {
+				specs[spec.Text] = spec
+			}
+			Ω(specs["WidgetB dog"].State).Should(Equal("will-run"))
+			Ω(specs["WidgetB dog"].Labels).Should(Equal([]string{"slow"}))
+			Ω(specs["WidgetB dog"].Location.FileName).Should(HaveSuffix("widget_b_test.go"))
+			Ω(specs["WidgetB dog"].ID).ShouldNot(BeEmpty())
+			Ω(specs["WidgetB cat"].State).Should(Equal("skipped"))
+			Ω(specs["SprocketA pending dog"].State).Should(Equal("pending"))
+		})
+
+		It("lists the specs in the suite as text, including their focus state", func() {
+			fm.MountFixture("focused")
+			session := startGinkgo(fm.PathTo("focused"), "list", "--no-color")
+			Eventually(session).Should(gexec.Exit(0))
+			output := string(session.Out.Contents())
+
+			Ω(output).Should(ContainSubstring("Focused Suite (12 specs)"))
+			Ω(output).Should(MatchRegexp(`\[will run\] \[focused\] [0-9a-f]{12} FocusedFixture focused focused`))
+			Ω(output).Should(MatchRegexp(`\[skipped\] [0-9a-f]{12} FocusedFixture not focused not focused`))
+			Ω(output).Should(ContainSubstring("focused_fixture_test.go:9"))
+		})
+	})
+
 	Describe("ginkgo version", func() {
 		It("should print out the version info", func() {
 			session := startGinkgo("", "version")
@@ -11,15 +11,96 @@ type Failer struct {
 	lock    *sync.Mutex
 	failure types.Failure
 	state   types.SpecState
+
+	quarantinedGoroutines map[uint64]bool
+	route                 FailerRoute
 }
 
+// FailerRoute returns the Failer that a call to Fail, Skip, AbortSuite or Panic made on the current goroutine should be forwarded to.
+// It returns nil if the call should be handled by the Failer the route is attached to.  message is the message passed to the call.
+type FailerRoute func(action string, message string, location types.CodeLocation) *Failer
+
 func NewFailer() *Failer {
 	return &Failer{
-		lock:  &sync.Mutex{},
-		state: types.SpecStatePassed,
+		lock:                  &sync.Mutex{},
+		state:                 types.SpecStatePassed,
+		quarantinedGoroutines: map[uint64]bool{},
 	}
 }
 
+/*
+Quarantine instructs the failer to ignore all subsequent calls made from the goroutine with the passed-in ID.
+
+Ginkgo quarantines the goroutine running a node's body when it abandons the node (e.g. because the node did not exit within its grace period after being timed out or interrupted).
+This prevents a leaked goroutine from failing whatever spec happens to be running when it eventually calls Fail.  If the runtime reports goroutine lineage
+(see RuntimeReportsGoroutineLineage) calls made from goroutines launched by a quarantined goroutine are ignored too.
+
+Quarantined goroutines are never released: abandoned goroutines may outlive the suite and goroutine IDs are never reused, so the set only grows for the life of the suite.
+*/
+func (f *Failer) Quarantine(goroutineID uint64) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.quarantinedGoroutines[goroutineID] = true
+}
+
+// HasQuarantined returns true if the goroutine with the passed-in ID has been quarantined
+func (f *Failer) HasQuarantined(goroutineID uint64) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.quarantinedGoroutines[goroutineID]
+}
+
+// IsQuarantined returns true if the current goroutine (or, if the runtime reports goroutine lineage, a goroutine that launched it) has been quarantined
+func (f *Failer) IsQuarantined() bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.isQuarantined()
+}
+
+// must be called with the lock held
+func (f *Failer) isQuarantined() bool {
+	if len(f.quarantinedGoroutines) == 0 {
+		return false
+	}
+	if f.quarantinedGoroutines[CurrentGoroutineID()] {
+		return true
+	}
+	if !RuntimeReportsGoroutineLineage() {
+		return false
+	}
+	for _, ancestorID := range CurrentGoroutineLineage(maxGoroutineLineageDepth)[1:] {
+		if f.quarantinedGoroutines[ancestorID] {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+RouteTo instructs the failer to forward calls to Fail, Skip, AbortSuite and Panic to the Failer returned by route.  Pass in nil to stop routing.
+
+Ginkgo uses this when running specs concurrently with --parallel-mode=goroutines: each goroutine running specs has its own Failer
+and calls made to the suite's Failer are forwarded to the Failer of the goroutine running the current spec.
+*/
+func (f *Failer) RouteTo(route FailerRoute) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.route = route
+}
+
+func (f *Failer) routedFailer(action string, message string, location types.CodeLocation) *Failer {
+	f.lock.Lock()
+	route := f.route
+	f.lock.Unlock()
+	if route == nil {
+		return nil
+	}
+	if target := route(action, message, location); target != f {
+		return target
+	}
+	return nil
+}
+
 func (f *Failer) GetState() types.SpecState {
 	f.lock.Lock()
 	defer f.lock.Unlock()
@@ -33,10 +114,15 @@ func (f *Failer) GetFailure() types.Failure {
 }
 
 func (f *Failer) Panic(location types.CodeLocation, forwardedPanic interface{}) {
+	if target := f.routedFailer("GinkgoRecover", fmt.Sprintf("Test Panicked: %v", forwardedPanic), location); target != nil {
+		target.Panic(location, forwardedPanic)
+		return
+	}
+
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
-	if f.state == types.SpecStatePassed {
+	if f.state == types.SpecStatePassed && !f.isQuarantined() {
 		f.state = types.SpecStatePanicked
 		f.failure = types.Failure{
 			Message:        "Test Panicked",
@@ -47,10 +133,15 @@ func (f *Failer) Panic(location types.CodeLocation, forwardedPanic interface{})
 }
 
 func (f *Failer) Fail(message string, location types.CodeLocation) {
+	if target := f.routedFailer("Fail", message, location); target != nil {
+		target.Fail(message, location)
+		return
+	}
+
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
-	if f.state == types.SpecStatePassed {
+	if f.state == types.SpecStatePassed && !f.isQuarantined() {
 		f.state = types.SpecStateFailed
 		f.failure = types.Failure{
 			Message:  message,
@@ -60,10 +151,15 @@ func (f *Failer) Fail(message string, location types.CodeLocation) {
 }
 
 func (f *Failer) Skip(message string, location types.CodeLocation) {
+	if target := f.routedFailer("Skip", message, location); target != nil {
+		target.Skip(message, location)
+		return
+	}
+
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
-	if f.state == types.SpecStatePassed {
+	if f.state == types.SpecStatePassed && !f.isQuarantined() {
 		f.state = types.SpecStateSkipped
 		f.failure = types.Failure{
 			Message:  message,
@@ -73,10 +169,15 @@ func (f *Failer) Skip(message string, location types.CodeLocation) {
 }
 
 func (f *Failer) AbortSuite(message string, location types.CodeLocation) {
+	if target := f.routedFailer("AbortSuite", message, location); target != nil {
+		target.AbortSuite(message, location)
+		return
+	}
+
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
-	if f.state == types.SpecStatePassed {
+	if f.state == types.SpecStatePassed && !f.isQuarantined() {
 		f.state = types.SpecStateAborted
 		f.failure = types.Failure{
 			Message:  message,
@@ -89,6 +190,10 @@ func (f *Failer) Drain() (types.SpecState, types.Failure) {
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
+	if f.isQuarantined() {
+		return types.SpecStatePassed, types.Failure{}
+	}
+
 	failure := f.failure
 	outcome := f.state
 
@@ -167,4 +167,111 @@ var _ = Describe("Failer", func() {
 			}))
 		})
 	})
+
+	Context("when a goroutine has been quarantined", func() {
+		BeforeEach(func() {
+			c := make(chan uint64)
+			done := make(chan interface{})
+			go func() {
+				defer GinkgoRecover()
+				c <- internal.CurrentGoroutineID()
+				<-done
+				failer.Fail("from the quarantined goroutine", clA)
+				failer.Panic(clA, "boom")
+				failer.Skip("skip", clA)
+				failer.AbortSuite("abort", clA)
+				state, failure := failer.Drain()
+				Ω(state).Should(Equal(types.SpecStatePassed))
+				Ω(failure).Should(BeZero())
+				close(c)
+			}()
+			failer.Fail("something failed", clB)
+			failer.Quarantine(<-c)
+			close(done)
+			Eventually(c).Should(BeClosed())
+		})
+
+		It("ignores calls made from the quarantined goroutine", func() {
+			state, failure := failer.Drain()
+			Ω(state).Should(Equal(types.SpecStateFailed))
+			Ω(failure).Should(Equal(types.Failure{
+				Message:  "something failed",
+				Location: clB,
+			}))
+		})
+
+		It("continues to honor calls made from other goroutines", func() {
+			failer.Drain()
+			failer.Fail("something else failed", clB)
+			state, _ := failer.Drain()
+			Ω(state).Should(Equal(types.SpecStateFailed))
+		})
+	})
+
+	Context("when a goroutine launched by a quarantined goroutine fails", func() {
+		BeforeEach(func() {
+			if !internal.RuntimeReportsGoroutineLineage() {
+				Skip("this runtime does not report goroutine lineage")
+			}
+			c := make(chan uint64)
+			release, done := make(chan interface{}), make(chan interface{})
+			go func() {
+				c <- internal.CurrentGoroutineID()
+				go func() {
+					<-release
+					failer.Fail("from a goroutine launched by the quarantined goroutine", clA)
+					close(done)
+				}()
+				<-done
+			}()
+			failer.Quarantine(<-c)
+			close(release)
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("ignores the failure", func() {
+			state, failure := failer.Drain()
+			Ω(state).Should(Equal(types.SpecStatePassed))
+			Ω(failure).Should(BeZero())
+		})
+	})
+
+	Describe("routing", func() {
+		var target *internal.Failer
+		var actions, messages []string
+		BeforeEach(func() {
+			target = internal.NewFailer()
+			actions, messages = []string{}, []string{}
+			failer.RouteTo(func(action string, message string, location types.CodeLocation) *internal.Failer {
+				actions = append(actions, action)
+				messages = append(messages, message)
+				return target
+			})
+		})
+
+		It("forwards failures to the failer returned by the route", func() {
+			failer.Fail("routed", clA)
+			Ω(actions).Should(Equal([]string{"Fail"}))
+			Ω(messages).Should(Equal([]string{"routed"}))
+			Ω(failer.GetState()).Should(Equal(types.SpecStatePassed))
+			state, failure := target.Drain()
+			Ω(state).Should(Equal(types.SpecStateFailed))
+			Ω(failure.Message).Should(Equal("routed"))
+		})
+
+		It("handles failures itself when the route returns nil", func() {
+			target = nil
+			failer.Skip("not routed", clA)
+			Ω(actions).Should(Equal([]string{"Skip"}))
+			Ω(failer.GetState()).Should(Equal(types.SpecStateSkipped))
+		})
+
+		It("stops routing when the route is cleared", func() {
+			failer.RouteTo(nil)
+			failer.Panic(clA, "boom")
+			Ω(actions).Should(BeEmpty())
+			Ω(failer.GetState()).Should(Equal(types.SpecStatePanicked))
+			Ω(target.GetState()).Should(Equal(types.SpecStatePassed))
+		})
+	})
 })
@@ -46,9 +46,10 @@ func ApplyNestedFocusPolicyToTree(tree *TreeNode) {
 /*
 	Ginkgo supports focussing specs using `FIt`, `FDescribe`, etc. - this is called "programmatic focus"
 	It also supports focussing specs using regular expressions on the command line (`-focus=`, `-skip=`) that match against spec text
-	and file filters (`-focus-files=`, `-skip-files=`) that match against code locations for nodes in specs.
+	file filters (`-focus-files=`, `-skip-files=`) that match against code locations for nodes in specs, and ID filters (`-focus-id=`, `-skip-id=`)
+	that match against the spec's stable ID.
 
-	If any of the CLI flags are provided they take precedence.  The file filters run first followed by the regex filters.
+	If any of the CLI flags are provided they take precedence.  The file filters run first followed by the ID filters and then the regex filters.
 
 	This function sets the `Skip` property on specs by applying Ginkgo's focus policy:
 	- If there are no CLI arguments and no programmatic focus, do nothing.
@@ -61,7 +62,7 @@ func ApplyFocusToSpecs(specs Specs, description string, suiteConfig types.SuiteC
 	focusString := strings.Join(suiteConfig.FocusStrings, "|")
 	skipString := strings.Join(suiteConfig.SkipStrings, "|")
 
-	hasFocusCLIFlags := focusString != "" || skipString != "" || len(suiteConfig.SkipFiles) > 0 || len(suiteConfig.FocusFiles) > 0 || suiteConfig.LabelFilter != ""
+	hasFocusCLIFlags := focusString != "" || skipString != "" || len(suiteConfig.SkipFiles) > 0 || len(suiteConfig.FocusFiles) > 0 || len(suiteConfig.FocusIDs) > 0 || len(suiteConfig.SkipIDs) > 0 || suiteConfig.LabelFilter != ""
 
 	type SkipCheck func(spec Spec) bool
 
@@ -95,6 +96,16 @@ func ApplyFocusToSpecs(specs Specs, description string, suiteConfig types.SuiteC
 		skipChecks = append(skipChecks, func(spec Spec) bool { return skipFilters.Matches(spec.Nodes.CodeLocations()) })
 	}
 
+	if len(suiteConfig.FocusIDs) > 0 {
+		focusIDs := specIDSet(suiteConfig.FocusIDs)
+		skipChecks = append(skipChecks, func(spec Spec) bool { return !focusIDs[spec.ID] })
+	}
+
+	if len(suiteConfig.SkipIDs) > 0 {
+		skipIDs := specIDSet(suiteConfig.SkipIDs)
+		skipChecks = append(skipChecks, func(spec Spec) bool { return skipIDs[spec.ID] })
+	}
+
 	if focusString != "" {
 		// skip specs that don't match the focus string
 		re := regexp.MustCompile(focusString)
@@ -121,3 +132,11 @@ func ApplyFocusToSpecs(specs Specs, description string, suiteConfig types.SuiteC
 
 	return processedSpecs, hasProgrammaticFocus
 }
+
+func specIDSet(ids []string) map[string]bool {
+	out := map[string]bool{}
+	for _, id := range ids {
+		out[strings.ToLower(strings.TrimSpace(id))] = true
+	}
+	return out
+}
@@ -252,6 +252,35 @@ var _ = Describe("Focus", func() {
 			})
 		})
 
+		Context("when configured to focus/skip ids", func() {
+			BeforeEach(func() {
+				specs = Specs{
+					{Nodes: Nodes{N()}, ID: "aaa"},        //include because "aaa" is in FocusIDs
+					{Nodes: Nodes{N()}, ID: "bbb"},        //skip because "bbb" is not in FocusIDs
+					{Nodes: Nodes{N(Focus)}, ID: "ccc"},   //skip because "ccc" is not in FocusIDs - override programmatic focus
+					{Nodes: Nodes{N()}, ID: "ddd"},        //skip because "ddd" is in SkipIDs
+					{Nodes: Nodes{N(Pending)}, ID: "eee"}, //skip because spec is flagged pending
+					{Nodes: Nodes{N()}, ID: "aaa-2"},      //include because "aaa-2" is in FocusIDs
+				}
+
+				conf.FocusIDs = []string{"AAA", "ddd", "eee", " aaa-2 "}
+				conf.SkipIDs = []string{"ddd"}
+			})
+
+			It("applies an id-based focus and skip filter", func() {
+				specs, hasProgrammaticFocus := internal.ApplyFocusToSpecs(specs, description, conf)
+				Ω(harvestSkips(specs)).Should(Equal([]bool{false, true, true, true, true, false}))
+				Ω(hasProgrammaticFocus).Should(BeFalse())
+			})
+
+			It("can skip specs without focusing", func() {
+				conf.FocusIDs = nil
+				specs, hasProgrammaticFocus := internal.ApplyFocusToSpecs(specs, description, conf)
+				Ω(harvestSkips(specs)).Should(Equal([]bool{false, false, false, true, true, false}))
+				Ω(hasProgrammaticFocus).Should(BeFalse())
+			})
+		})
+
 		Context("when configured with focus/skip files, focus/skip strings, and label filters", func() {
 			BeforeEach(func() {
 				specs = Specs{
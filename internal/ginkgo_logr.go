@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+)
+
+/*
+NewGinkgoLogr returns a logr.Logger that emits structured key/value lines to the io.Writer returned by writer.
+
+writer and verbosity are evaluated each time a line is logged.  This allows the logger to be constructed before the suite is configured
+and ensures that lines are always written to the GinkgoWriter of the suite that is currently running.  Info lines are only emitted if their
+V-level is less than or equal to verbosity().  Error lines are always emitted.
+*/
+func NewGinkgoLogr(writer func() io.Writer, verbosity func() int) logr.Logger {
+	return logr.New(&ginkgoLogSink{
+		Formatter: funcr.NewFormatter(funcr.Options{}),
+		writer:    writer,
+		verbosity: verbosity,
+	})
+}
+
+type ginkgoLogSink struct {
+	funcr.Formatter
+	writer    func() io.Writer
+	verbosity func() int
+}
+
+func (l *ginkgoLogSink) Enabled(level int) bool {
+	return level <= l.verbosity()
+}
+
+func (l *ginkgoLogSink) Info(level int, msg string, kvList ...interface{}) {
+	prefix, args := l.FormatInfo(level, msg, kvList)
+	l.emit(prefix, args)
+}
+
+func (l *ginkgoLogSink) Error(err error, msg string, kvList ...interface{}) {
+	prefix, args := l.FormatError(err, msg, kvList)
+	l.emit(prefix, args)
+}
+
+func (l ginkgoLogSink) WithName(name string) logr.LogSink {
+	l.AddName(name)
+	return &l
+}
+
+func (l ginkgoLogSink) WithValues(kvList ...interface{}) logr.LogSink {
+	l.AddValues(kvList)
+	return &l
+}
+
+func (l ginkgoLogSink) WithCallDepth(depth int) logr.LogSink {
+	l.AddCallDepth(depth)
+	return &l
+}
+
+func (l *ginkgoLogSink) emit(prefix string, args string) {
+	if prefix == "" {
+		fmt.Fprintf(l.writer(), "%s\n", args)
+	} else {
+		fmt.Fprintf(l.writer(), "%s: %s\n", prefix, args)
+	}
+}
@@ -0,0 +1,67 @@
+package internal_test
+
+import (
+	"fmt"
+	"io"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-logr/logr"
+	"github.com/onsi-experimental/ginkgo/v2/internal"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("GinkgoLogr", func() {
+	var out *gbytes.Buffer
+	var verbosity int
+	var logger logr.Logger
+
+	BeforeEach(func() {
+		out = gbytes.NewBuffer()
+		verbosity = 0
+		logger = internal.NewGinkgoLogr(func() io.Writer { return out }, func() int { return verbosity })
+	})
+
+	It("emits structured key/value lines", func() {
+		logger.Info("hello", "name", "bob", "count", 3)
+		Ω(string(out.Contents())).Should(Equal(`"level"=0 "msg"="hello" "name"="bob" "count"=3` + "\n"))
+	})
+
+	It("includes names and values", func() {
+		logger.WithName("db").WithName("conn").WithValues("host", "localhost").Info("connected", "attempt", 2)
+		Ω(string(out.Contents())).Should(Equal(`db/conn: "level"=0 "msg"="connected" "host"="localhost" "attempt"=2` + "\n"))
+	})
+
+	It("does not share values between derived loggers", func() {
+		base := logger.WithValues("a", 1)
+		base.WithValues("b", 2).Info("first")
+		base.Info("second")
+		Ω(out).Should(gbytes.Say(`"msg"="first" "a"=1 "b"=2\n`))
+		Ω(out).Should(gbytes.Say(`"msg"="second" "a"=1\n`))
+	})
+
+	It("always emits errors", func() {
+		verbosity = -1
+		logger.Error(fmt.Errorf("boom"), "failed", "key", "value")
+		Ω(string(out.Contents())).Should(Equal(`"msg"="failed" "error"="boom" "key"="value"` + "\n"))
+	})
+
+	It("only emits lines at or below the current verbosity", func() {
+		logger.V(1).Info("not yet")
+		Ω(out.Contents()).Should(BeEmpty())
+
+		verbosity = 1
+		logger.V(1).Info("now")
+		logger.V(2).Info("still not")
+		Ω(string(out.Contents())).Should(Equal(`"level"=1 "msg"="now"` + "\n"))
+	})
+
+	It("writes to the current writer each time it logs", func() {
+		other := gbytes.NewBuffer()
+		logger = internal.NewGinkgoLogr(func() io.Writer { return other }, func() int { return 0 })
+		logger.Info("elsewhere")
+		Ω(out.Contents()).Should(BeEmpty())
+		Ω(other).Should(gbytes.Say(`"msg"="elsewhere"`))
+	})
+})
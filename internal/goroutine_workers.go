@@ -0,0 +1,281 @@
+package internal
+
+import (
+	"sync"
+
+	"github.com/onsi-experimental/ginkgo/v2/internal/parallel_support"
+	"github.com/onsi-experimental/ginkgo/v2/reporters"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+/*
+  Running specs concurrently with --parallel-mode=goroutines
+
+  Each goroutine running specs is backed by a worker: a Suite that shares the spec tree, configuration, reporter and interrupt handler of
+  the Suite that spawned it but that tracks its own running spec, Failer and GinkgoWriter output.  While workers are running, the suite
+  routes calls made through the DSL (Fail, GinkgoWriter, CurrentSpecReport, By, DeferCleanup, etc.) to the worker whose node is running
+  on the calling goroutine - or on one of the goroutines that created it.
+*/
+
+// maxGoroutineLineageDepth bounds how far up a goroutine's lineage Ginkgo will look when attributing it to a running spec
+const maxGoroutineLineageDepth = 16
+
+func (suite *Suite) newGoroutineWorker(reporter reporters.Reporter) *Suite {
+	return &Suite{
+		tree:              suite.tree,
+		phase:             PhaseRun,
+		failer:            NewFailer(),
+		reporter:          reporter,
+		writer:            suite.writer.Fork(),
+		outputInterceptor: NoopOutputInterceptor{},
+		interruptHandler:  suite.interruptHandler,
+		config:            suite.config,
+		selectiveLock:     &sync.Mutex{},
+		reportLock:        &sync.Mutex{},
+		parent:            suite,
+	}
+}
+
+// runGroupsConcurrently runs the passed-in spec groups across numWorkers goroutines and returns once all the groups have run
+func (suite *Suite) runGroupsConcurrently(specs Specs, groupedSpecIndices GroupedSpecIndices, numWorkers int) {
+	reporter := &goroutineWorkerReporter{lock: &sync.Mutex{}, reporter: suite.reporter}
+	workers := make([]*Suite, numWorkers)
+	for i := range workers {
+		workers[i] = suite.newGoroutineWorker(reporter)
+	}
+
+	suite.reportLock.Lock()
+	suite.workers = workers
+	// calls made from abandoned node goroutines are routed to a worker that never reports, so they can't affect the specs that are still running
+	suite.abandonedWorker = suite.newGoroutineWorker(reporters.NoopReporter{})
+	suite.goroutineAttributions = map[uint64]goroutineAttribution{}
+	suite.reportLock.Unlock()
+	suite.failer.RouteTo(suite.failerForCurrentGoroutine)
+	suite.writer.RouteTo(suite.writerForCurrentGoroutine)
+	defer func() {
+		suite.failer.RouteTo(nil)
+		suite.writer.RouteTo(nil)
+		suite.reportLock.Lock()
+		suite.workers = nil
+		suite.abandonedWorker = nil
+		suite.goroutineAttributions = nil
+		suite.reportLock.Unlock()
+	}()
+
+	// workers pull groups off a shared work queue which ensures that groups holding the same Exclusive key never run at the same time
+	order := make([]int, len(groupedSpecIndices))
+	for idx := range order {
+		order[idx] = idx
+	}
+	queue := parallel_support.NewWorkQueue(specGroupsForScheduling(specs, groupedSpecIndices), order)
+	queueLock := &sync.Mutex{}
+	leaseReleased := sync.NewCond(queueLock)
+	nextGroup := func(workerIdx int) int {
+		queueLock.Lock()
+		defer queueLock.Unlock()
+		for {
+			groupIdx, err := queue.Next(workerIdx)
+			if err == nil {
+				return groupIdx
+			}
+			leaseReleased.Wait()
+		}
+	}
+	releaseGroup := func(workerIdx int) {
+		queueLock.Lock()
+		defer queueLock.Unlock()
+		queue.Release(workerIdx)
+		leaseReleased.Broadcast()
+	}
+
+	wg := &sync.WaitGroup{}
+	for workerIdx, worker := range workers {
+		wg.Add(1)
+		go func(workerIdx int, worker *Suite) {
+			defer wg.Done()
+			for {
+				groupIdx := nextGroup(workerIdx)
+				if groupIdx >= len(groupedSpecIndices) {
+					return
+				}
+				worker.runGroup(specs.AtIndices(groupedSpecIndices[groupIdx]))
+				releaseGroup(workerIdx)
+			}
+		}(workerIdx, worker)
+	}
+	wg.Wait()
+}
+
+/*
+goroutineAttribution records the node goroutine that a goroutine was launched by (directly or transitively) and the worker running that node.
+
+Goroutine IDs are never reused and a goroutine's lineage never changes, so once a goroutine has been attributed Ginkgo doesn't need to walk
+its lineage again (which requires a stack trace of every goroutine).  The attribution only holds while the worker is still running the node:
+once the node ends, the goroutine can no longer be attributed to a spec.  A zero-value attribution records a goroutine that could not be attributed.
+*/
+type goroutineAttribution struct {
+	worker          *Suite
+	nodeGoroutineID uint64
+}
+
+/*
+workerForCurrentGoroutine returns the worker running the spec that the current goroutine belongs to.  It returns nil, true if specs
+are not currently running concurrently.
+
+ok is false if specs are running concurrently but the current goroutine cannot be attributed to any of them.  Goroutines that belong to a node that
+Ginkgo has abandoned are attributed to a worker that never reports so that they can't affect the spec their worker is now running.
+*/
+func (suite *Suite) workerForCurrentGoroutine() (worker *Suite, ok bool) {
+	suite.reportLock.Lock()
+	workers, abandonedWorker := suite.workers, suite.abandonedWorker
+	suite.reportLock.Unlock()
+	if len(workers) == 0 {
+		return nil, true
+	}
+
+	goroutineID := CurrentGoroutineID()
+	suite.reportLock.Lock()
+	attribution, cached := suite.goroutineAttributions[goroutineID]
+	suite.reportLock.Unlock()
+	if !cached {
+		attribution = attributeGoroutine(workers, goroutineID)
+		suite.reportLock.Lock()
+		if suite.goroutineAttributions != nil {
+			suite.goroutineAttributions[goroutineID] = attribution
+		}
+		suite.reportLock.Unlock()
+	}
+
+	if attribution.worker == nil {
+		return nil, false
+	}
+	attribution.worker.selectiveLock.Lock()
+	isRunningNode := attribution.worker.currentNodeGoroutineID == attribution.nodeGoroutineID
+	attribution.worker.selectiveLock.Unlock()
+	if attribution.worker.failer.HasQuarantined(attribution.nodeGoroutineID) {
+		return abandonedWorker, true
+	}
+	if !isRunningNode {
+		return nil, false
+	}
+	return attribution.worker, true
+}
+
+// attributeGoroutine walks the goroutine's lineage looking for the node goroutine that launched it
+func attributeGoroutine(workers []*Suite, goroutineID uint64) goroutineAttribution {
+	if attribution := attributionForNodeGoroutine(workers, goroutineID); attribution.worker != nil {
+		return attribution
+	}
+	for _, ancestorID := range CurrentGoroutineLineage(maxGoroutineLineageDepth)[1:] {
+		if attribution := attributionForNodeGoroutine(workers, ancestorID); attribution.worker != nil {
+			return attribution
+		}
+	}
+	return goroutineAttribution{}
+}
+
+func attributionForNodeGoroutine(workers []*Suite, goroutineID uint64) goroutineAttribution {
+	if goroutineID == 0 {
+		return goroutineAttribution{}
+	}
+	for _, worker := range workers {
+		worker.selectiveLock.Lock()
+		isRunningGoroutine := worker.currentNodeGoroutineID == goroutineID
+		worker.selectiveLock.Unlock()
+		if isRunningGoroutine || worker.failer.HasQuarantined(goroutineID) {
+			return goroutineAttribution{worker: worker, nodeGoroutineID: goroutineID}
+		}
+	}
+	return goroutineAttribution{}
+}
+
+func (suite *Suite) failerForCurrentGoroutine(action string, message string, location types.CodeLocation) *Failer {
+	worker, ok := suite.workerForCurrentGoroutine()
+	if !ok {
+		// the failure can't be attributed to a spec so it is recorded as a suite failure and we hand back a Failer that no one will drain
+		suite.recordGoroutineError(types.GinkgoErrors.UnattributableFailureInGoroutineParallelMode(action, message, location))
+		return NewFailer()
+	}
+	if worker == nil {
+		return nil
+	}
+	return worker.failer
+}
+
+func (suite *Suite) writerForCurrentGoroutine() WriterInterface {
+	// output that can't be attributed to a spec is written to the suite's writer
+	worker, _ := suite.workerForCurrentGoroutine()
+	if worker == nil {
+		return nil
+	}
+	return worker.writer
+}
+
+// recordGoroutineError records errors that arise when specs running concurrently use Ginkgo's global state in an unsupported way.
+// These errors fail the suite.
+func (suite *Suite) recordGoroutineError(err error) {
+	suite.reportLock.Lock()
+	defer suite.reportLock.Unlock()
+	for _, reason := range suite.report.SpecialSuiteFailureReasons {
+		if reason == err.Error() {
+			return
+		}
+	}
+	suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, err.Error())
+	suite.report.SuiteSucceeded = false
+}
+
+/*
+goroutineWorkerReporter serializes the calls workers make to the suite's reporter.
+
+Like the parallel server used by --parallel-mode=processes, it does not forward WillRun: the spec headers emitted by WillRun would otherwise be
+interleaved with the reports of specs running on other goroutines.
+*/
+type goroutineWorkerReporter struct {
+	lock     *sync.Mutex
+	reporter reporters.Reporter
+}
+
+func (r *goroutineWorkerReporter) SuiteWillBegin(report types.Report) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.reporter.SuiteWillBegin(report)
+}
+
+func (r *goroutineWorkerReporter) WillRun(report types.SpecReport) {}
+
+func (r *goroutineWorkerReporter) DidRun(report types.SpecReport) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.reporter.DidRun(report)
+}
+
+func (r *goroutineWorkerReporter) SuiteDidEnd(report types.Report) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.reporter.SuiteDidEnd(report)
+}
+
+func (r *goroutineWorkerReporter) EmitProgressReport(progressReport types.ProgressReport) {
+	if progressReporter, ok := r.reporter.(reporters.ProgressReporter); ok {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		progressReporter.EmitProgressReport(progressReport)
+	}
+}
+
+func (r *goroutineWorkerReporter) NodeWillRun(report types.SpecReport, event types.NodeEvent) {
+	if nodeReporter, ok := r.reporter.(reporters.NodeReporter); ok {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		nodeReporter.NodeWillRun(report, event)
+	}
+}
+
+func (r *goroutineWorkerReporter) NodeDidRun(report types.SpecReport, event types.NodeEvent) {
+	if nodeReporter, ok := r.reporter.(reporters.NodeReporter); ok {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		nodeReporter.NodeDidRun(report, event)
+	}
+}
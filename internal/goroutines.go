@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The runtime does not expose goroutine IDs directly.  They are, however, available in the header line of every goroutine's stack trace
+// ("goroutine 18 [running]:") and this is enough for Ginkgo to identify the goroutine running a node's body.
+
+func CurrentGoroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	return goroutineIDFromHeader(buf)
+}
+
+func goroutineIDFromHeader(header []byte) uint64 {
+	fields := bytes.Fields(header)
+	if len(fields) < 2 || string(fields[0]) != "goroutine" {
+		return 0
+	}
+	id, err := strconv.ParseUint(string(fields[1]), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// StackTraceForGoroutine returns the stack trace for the goroutine with the passed-in ID, or "" if no such goroutine is running
+func StackTraceForGoroutine(id uint64) string {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	for _, stack := range strings.Split(string(buf), "\n\n") {
+		if goroutineIDFromHeader([]byte(stack)) == id {
+			return stack
+		}
+	}
+	return ""
+}
+
+// CurrentGoroutineLineage returns the ID of the current goroutine followed by the IDs of the goroutines that (transitively) created it.
+// The lineage ends at the first creator that has already exited, or that cannot be determined (e.g. on versions of Go that do not include
+// the creator's ID in stack traces).  The lineage includes at most maxDepth IDs.
+func CurrentGoroutineLineage(maxDepth int) []uint64 {
+	buf := make([]byte, 1<<12)
+	for {
+		n := runtime.Stack(buf, false)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	stack := string(buf)
+	lineage := []uint64{goroutineIDFromHeader(buf)}
+	for len(lineage) < maxDepth {
+		creatorID := creatorGoroutineID(stack)
+		if creatorID == 0 {
+			break
+		}
+		lineage = append(lineage, creatorID)
+		stack = StackTraceForGoroutine(creatorID)
+		if stack == "" {
+			break
+		}
+	}
+	return lineage
+}
+
+var goroutineLineageOnce sync.Once
+var goroutineLineageIsReported bool
+
+// RuntimeReportsGoroutineLineage returns true if the Go runtime includes the ID of a goroutine's creator in its stack trace (Go 1.21 and later).
+// Ginkgo relies on this to attribute goroutines launched by a node's body to the spec that launched them.
+func RuntimeReportsGoroutineLineage() bool {
+	goroutineLineageOnce.Do(func() {
+		parentID := CurrentGoroutineID()
+		lineage := make(chan []uint64)
+		go func() {
+			lineage <- CurrentGoroutineLineage(2)
+		}()
+		l := <-lineage
+		goroutineLineageIsReported = len(l) == 2 && l[1] == parentID
+	})
+	return goroutineLineageIsReported
+}
+
+// creatorGoroutineID extracts the ID of the creating goroutine from a stack trace's "created by X in goroutine N" line
+func creatorGoroutineID(stack string) uint64 {
+	idx := strings.LastIndex(stack, "\ncreated by ")
+	if idx == -1 {
+		return 0
+	}
+	line := stack[idx+1:]
+	if end := strings.IndexByte(line, '\n'); end != -1 {
+		line = line[:end]
+	}
+	idx = strings.LastIndex(line, " in goroutine ")
+	if idx == -1 {
+		return 0
+	}
+	id, err := strconv.ParseUint(strings.TrimSpace(line[idx+len(" in goroutine "):]), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
@@ -50,3 +50,24 @@ var _ = Describe("when config.DryRun is enabled", func() {
 		Ω(reporter.End).Should(BeASuiteSummary(NSpecs(5), NPassed(3), NPending(1), NSkipped(1)))
 	})
 })
+
+var _ = Describe("when config.DryRun is enabled in goroutine parallel mode", func() {
+	BeforeEach(func() {
+		conf.DryRun = true
+		conf.ParallelMode = "goroutines"
+		conf.ParallelGoroutines = 3
+
+		RunFixture("dry run", func() {
+			It("A", rt.T("A"))
+			It("B", rt.T("B"))
+			It("C", Serial, rt.T("C"))
+		})
+	})
+
+	It("does not distribute the specs and reports on them in series", func() {
+		Ω(rt).Should(HaveTrackedNothing())
+		Ω(reporter.Will.Names()).Should(ConsistOf("A", "B", "C"))
+		Ω(reporter.Did.Names()).Should(Equal(reporter.Will.Names()))
+		Ω(reporter.End).Should(BeASuiteSummary(NSpecs(3), NPassed(3)))
+	})
+})
@@ -0,0 +1,95 @@
+package internal_integration_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when a spec must pass repeatedly", func() {
+	var success bool
+	JustBeforeEach(func() {
+		var counterB int
+
+		success, _ = RunFixture("repeated specs", func() {
+			BeforeEach(rt.T("bef"))
+			It("A", rt.T("A", func() {
+				writer.Write([]byte("A\n"))
+			}))
+			It("B", MustPassRepeatedly(4), rt.T("B", func() {
+				counterB += 1
+				writer.Write([]byte(fmt.Sprintf("B - attempt #%d\n", counterB)))
+				if counterB == 3 {
+					F(fmt.Sprintf("B - %d", counterB))
+				}
+			}))
+			AfterEach(rt.T("aft", func() {
+				DeferCleanup(rt.T("cleanup"))
+			}))
+		})
+	})
+
+	Context("with the MustPassRepeatedly decorator", func() {
+		It("runs the spec, including its setup and cleanup nodes, until it fails", func() {
+			Ω(success).Should(BeFalse())
+			Ω(rt).Should(HaveTracked(
+				"bef", "A", "aft", "cleanup",
+				"bef", "B", "aft", "cleanup",
+				"bef", "B", "aft", "cleanup",
+				"bef", "B", "aft", "cleanup",
+			))
+		})
+
+		It("reports the number of attempts and the attempt that failed", func() {
+			Ω(reporter.Did.Find("A")).Should(HavePassed(NumAttempts(1)))
+			Ω(reporter.Did.Find("A").MaxMustPassRepeatedly).Should(Equal(0))
+			Ω(reporter.Did.Find("B")).Should(HaveFailed("B - 3", NumAttempts(3),
+				CapturedGinkgoWriterOutput("B - attempt #1\n\nGinkgo: Attempt #1 Passed.  Repeating...\nB - attempt #2\n\nGinkgo: Attempt #2 Passed.  Repeating...\nB - attempt #3\n")))
+			Ω(reporter.Did.Find("B").MaxMustPassRepeatedly).Should(Equal(4))
+		})
+
+		It("does not count the spec as flaky", func() {
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(2), NFailed(1), NPassed(1), NFlaked(0)))
+		})
+	})
+
+	Context("with --must-pass-repeatedly", func() {
+		BeforeEach(func() {
+			conf.MustPassRepeatedly = 2
+		})
+
+		It("overrides the decorator and applies to every spec", func() {
+			Ω(success).Should(BeTrue())
+			Ω(rt).Should(HaveTracked(
+				"bef", "A", "aft", "cleanup",
+				"bef", "A", "aft", "cleanup",
+				"bef", "B", "aft", "cleanup",
+				"bef", "B", "aft", "cleanup",
+			))
+			Ω(reporter.Did.Find("A")).Should(HavePassed(NumAttempts(2), CapturedGinkgoWriterOutput("A\n\nGinkgo: Attempt #1 Passed.  Repeating...\nA\n")))
+			Ω(reporter.Did.Find("B")).Should(HavePassed(NumAttempts(2)))
+			Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(2), NPassed(2), NFlaked(0)))
+		})
+	})
+})
+
+var _ = Describe("when a spec in an Ordered container must pass repeatedly", func() {
+	BeforeEach(func() {
+		success, _ := RunFixture("repeated ordered specs", func() {
+			Describe("container", Ordered, func() {
+				BeforeAll(rt.T("bef-all"))
+				It("A", rt.T("A"))
+				It("B", MustPassRepeatedly(3), rt.T("B"))
+				AfterAll(rt.T("aft-all"))
+			})
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("runs the BeforeAll and AfterAll once, around all the repetitions", func() {
+		Ω(rt).Should(HaveTracked("bef-all", "A", "B", "B", "B", "aft-all"))
+		Ω(reporter.Did.Find("B")).Should(HavePassed(NumAttempts(3)))
+	})
+})
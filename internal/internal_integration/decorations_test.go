@@ -34,12 +34,17 @@ var _ = Describe("Decorations test", func() {
 					Skip("skip")
 				}))
 			})
+			Describe("prioritized-container", SpecPriority(3), func() {
+				It("inherits-priority", rt.T("inherits-priority"))
+				It("overrides-priority", SpecPriority(5), rt.T("overrides-priority"))
+			})
 		})
 		Ω(success).Should(BeFalse())
 	})
 
 	It("runs all the test nodes in the expected order", func() {
 		Ω(rt).Should(HaveTracked(
+			"overrides-priority", "inherits-priority",
 			"is-offset",
 			"flaky", "flaky", "flaky",
 			"never-passes", "never-passes",
@@ -54,6 +59,14 @@ var _ = Describe("Decorations test", func() {
 		})
 	})
 
+	Describe("SpecPriority", func() {
+		It("records the spec's priority, inheriting it from containers", func() {
+			Ω(reporter.Did.Find("overrides-priority").SpecPriority).Should(Equal(5))
+			Ω(reporter.Did.Find("inherits-priority").SpecPriority).Should(Equal(3))
+			Ω(reporter.Did.Find("is-offset").SpecPriority).Should(Equal(0))
+		})
+	})
+
 	Describe("FlakeAttempts", func() {
 		It("reruns tests until they pass or until the number of flake attempts is exhausted, but does not rerun skipped tests", func() {
 			Ω(reporter.Did.Find("flaky")).Should(HavePassed(NumAttempts(3), CapturedStdOutput("so flaky\nso flaky\nso flaky\n"), CapturedGinkgoWriterOutput("so tasty\n\nGinkgo: Attempt #1 Failed.  Retrying...\nso tasty\n\nGinkgo: Attempt #2 Failed.  Retrying...\nso tasty\n")))
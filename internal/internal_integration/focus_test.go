@@ -157,6 +157,41 @@ var _ = Describe("Focus", func() {
 		})
 	})
 
+	Describe("with config.FocusIDs and config.SkipIDs", func() {
+		var fixture func()
+		BeforeEach(func() {
+			fixture = func() {
+				It("A", rt.T("A"))
+				Describe("container", func() {
+					It("B", rt.T("B"))
+					It("C", rt.T("C"))
+				})
+				FIt("D", rt.T("D"))
+			}
+			success, _ := RunFixture("discover ids", fixture)
+			Ω(success).Should(BeTrue())
+			conf.FocusIDs = []string{reporter.Did.Find("A").SpecID, reporter.Did.Find("B").SpecID, reporter.Did.Find("C").SpecID}
+			conf.SkipIDs = []string{reporter.Did.Find("C").SpecID}
+
+			rt.Reset()
+			reporter = &FakeReporter{}
+			success, _ = RunFixture("cli id focus tests", fixture)
+			Ω(success).Should(BeTrue())
+		})
+
+		It("gives each spec a stable ID", func() {
+			Ω(reporter.Did.Find("A").SpecID).Should(Equal(conf.FocusIDs[0]))
+			Ω(reporter.Did.Find("B").SpecID).Should(Equal(conf.FocusIDs[1]))
+			Ω(reporter.Did.Find("A").SpecID).ShouldNot(Equal(reporter.Did.Find("B").SpecID))
+		})
+
+		It("should run tests that match", func() {
+			Ω(rt).Should(HaveTracked("A", "B"))
+			Ω(reporter.Did.WithState(types.SpecStateSkipped).Names()).Should(ConsistOf("C", "D"))
+			Ω(reporter.End).Should(BeASuiteSummary(true, NPassed(2), NSkipped(2), NSpecs(4), NWillRun(2)))
+		})
+	})
+
 	Describe("when no tests will end up running", func() {
 		BeforeEach(func() {
 			conf.FocusStrings = []string{"red"}
@@ -0,0 +1,320 @@
+package internal_integration_test
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	"github.com/onsi-experimental/ginkgo/v2/internal"
+	"github.com/onsi-experimental/ginkgo/v2/internal/global"
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Running specs in parallel goroutines", func() {
+	var waitForAll func(name string) func()
+
+	BeforeEach(func() {
+		if !internal.RuntimeReportsGoroutineLineage() {
+			Skip("--parallel-mode=goroutines requires a runtime that reports goroutine lineage")
+		}
+		conf.ParallelMode = "goroutines"
+		conf.ParallelGoroutines = 3
+
+		// specs that call the returned function only succeed if they are all running at the same time
+		lock := &sync.Mutex{}
+		arrived := 0
+		allArrived := make(chan interface{})
+		waitForAll = func(name string) func() {
+			return func() {
+				lock.Lock()
+				arrived += 1
+				if arrived == 3 {
+					close(allArrived)
+				}
+				lock.Unlock()
+				select {
+				case <-allArrived:
+					rt.Run(name)
+				case <-time.After(time.Second):
+					F("specs did not run concurrently")
+				}
+			}
+		}
+	})
+
+	Describe("running specs", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("goroutines", func() {
+				BeforeSuite(rt.T("before-suite"))
+				It("A", func() {
+					writer.Println("A-output")
+					waitForAll("A")()
+				})
+				It("B", func() {
+					writer.Println("B-output")
+					waitForAll("B")()
+				})
+				It("C", func() {
+					writer.Println("C-output")
+					waitForAll("C")()
+				})
+				It("D", Serial, rt.T("D", func() {
+					writer.Println("D-output")
+				}))
+				AfterSuite(rt.T("after-suite"))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs the parallelizable specs concurrently and the serial specs afterwards", func() {
+			Ω(rt.TrackedRuns()[0]).Should(Equal("before-suite"))
+			Ω(rt.TrackedRuns()[1:4]).Should(ConsistOf("A", "B", "C"))
+			Ω(rt.TrackedRuns()[4:]).Should(Equal([]string{"D", "after-suite"}))
+			Ω(reporter.Did.Find("A")).Should(HavePassed())
+			Ω(reporter.Did.Find("B")).Should(HavePassed())
+			Ω(reporter.Did.Find("C")).Should(HavePassed())
+			Ω(reporter.Did.Find("D")).Should(HavePassed())
+		})
+
+		It("captures each spec's GinkgoWriter output separately", func() {
+			Ω(reporter.Did.Find("A").CapturedGinkgoWriterOutput).Should(Equal("A-output\n"))
+			Ω(reporter.Did.Find("B").CapturedGinkgoWriterOutput).Should(Equal("B-output\n"))
+			Ω(reporter.Did.Find("C").CapturedGinkgoWriterOutput).Should(Equal("C-output\n"))
+			Ω(reporter.Did.Find("D").CapturedGinkgoWriterOutput).Should(Equal("D-output\n"))
+		})
+
+		It("only emits WillRun for the specs that run in series", func() {
+			Ω(reporter.Will.Names()).Should(Equal([]string{"D"}))
+			Ω(reporter.Did.Names()).Should(ConsistOf("A", "B", "C", "D"))
+		})
+
+		It("reports on all the specs", func() {
+			Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(4), NPassed(4)))
+		})
+	})
+
+	Describe("attributing failures, cleanup, and steps to the correct spec", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("goroutines", func() {
+				It("A", func() {
+					DeferCleanup(rt.T("cleanup-A"))
+					By("step-A")
+					waitForAll("A")()
+					F("failure-A")
+				})
+				It("B", func() {
+					DeferCleanup(rt.T("cleanup-B"))
+					By("step-B")
+					waitForAll("B")()
+					AddReportEntry("entry-B")
+				})
+				It("C", func() {
+					By("step-C")
+					done := make(chan interface{})
+					go func() {
+						defer GinkgoRecover()
+						defer close(done)
+						waitForAll("C")()
+						Ω(CurrentSpecReport().LeafNodeText).Should(Equal("C"))
+						F("failure-C")
+					}()
+					<-done
+				})
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("attributes each call to the spec that made it", func() {
+			Ω(rt.TrackedRuns()).Should(ConsistOf("A", "B", "C", "cleanup-A", "cleanup-B"))
+			Ω(reporter.Did.Find("A")).Should(HaveFailed("failure-A"))
+			Ω(reporter.Did.Find("B")).Should(HavePassed())
+			Ω(reporter.Did.Find("C")).Should(HaveFailed("failure-C"))
+
+			Ω(reporter.Did.Find("A").Steps).Should(HaveLen(1))
+			Ω(reporter.Did.Find("A").Steps[0].Text).Should(Equal("step-A"))
+			Ω(reporter.Did.Find("B").Steps).Should(HaveLen(1))
+			Ω(reporter.Did.Find("B").Steps[0].Text).Should(Equal("step-B"))
+			Ω(reporter.Did.Find("C").Steps).Should(HaveLen(1))
+			Ω(reporter.Did.Find("C").Steps[0].Text).Should(Equal("step-C"))
+
+			entryNames := func(report types.SpecReport) []string {
+				names := []string{}
+				for _, entry := range report.ReportEntries {
+					names = append(names, entry.Name)
+				}
+				return names
+			}
+			Ω(entryNames(reporter.Did.Find("A"))).ShouldNot(ContainElement("entry-B"))
+			Ω(entryNames(reporter.Did.Find("B"))).Should(ContainElement("entry-B"))
+			Ω(entryNames(reporter.Did.Find("C"))).ShouldNot(ContainElement("entry-B"))
+		})
+	})
+
+	Describe("ordered containers", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("goroutines", func() {
+				Describe("ordered", Ordered, func() {
+					BeforeAll(rt.T("before-all", func() { time.Sleep(10 * time.Millisecond) }))
+					It("A", rt.T("A", func() { time.Sleep(10 * time.Millisecond) }))
+					It("B", rt.T("B"))
+				})
+				It("C", rt.T("C"))
+				It("D", rt.T("D"))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs the specs in the container in order", func() {
+			Ω(rt.TrackedRuns()).Should(ConsistOf("before-all", "A", "B", "C", "D"))
+			orderedRuns := []string{}
+			for _, run := range rt.TrackedRuns() {
+				if run != "C" && run != "D" {
+					orderedRuns = append(orderedRuns, run)
+				}
+			}
+			Ω(orderedRuns).Should(Equal([]string{"before-all", "A", "B"}))
+			Ω(reporter.Did.Find("A")).Should(HavePassed())
+			Ω(reporter.Did.Find("B")).Should(HavePassed())
+		})
+	})
+
+	Describe("Exclusive specs", func() {
+		BeforeEach(func() {
+			lock := &sync.Mutex{}
+			dbHolders, dbUses := 0, 0
+			useDB := func(name string) func() {
+				return func() {
+					lock.Lock()
+					dbHolders += 1
+					dbUses += 1
+					holders, first := dbHolders, dbUses == 1
+					lock.Unlock()
+					if holders > 1 {
+						F(name + " ran while another spec held db")
+					}
+					// whichever spec gets db first runs alongside the non-exclusive specs
+					if first {
+						waitForAll(name)()
+					} else {
+						rt.Run(name)
+					}
+					lock.Lock()
+					dbHolders -= 1
+					lock.Unlock()
+				}
+			}
+			success, _ := RunFixture("goroutines", func() {
+				It("A", Exclusive("db"), useDB("A"))
+				It("B", Exclusive("db"), useDB("B"))
+				It("C", func() { waitForAll("C")() })
+				It("D", func() { waitForAll("D")() })
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("never runs specs holding the same key at the same time, but runs other specs alongside them", func() {
+			// the fixture fails if a spec gets db while another spec holds it, or if the first spec to get db doesn't run alongside C and D
+			Ω(rt.TrackedRuns()).Should(ConsistOf("A", "B", "C", "D"))
+			Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(4), NPassed(4)))
+		})
+	})
+
+	Describe("abandoned nodes", func() {
+		BeforeEach(func() {
+			conf.GracePeriod = time.Millisecond * 50
+			tracker, leakyFailer, leakyWriter := rt, failer, writer
+			release, leakDone := make(chan interface{}), make(chan interface{})
+			success, _ := RunFixture("goroutines", func() {
+				Context("ordered", Ordered, ContinueOnFailure, func() {
+					It("A", rt.TSC("A", func(c SpecContext) {
+						<-c.Done()
+						<-release
+						leakyWriter.Println("leaked-output")
+						leakyFailer.Fail("failure from leaked goroutine", cl)
+						tracker.Run("leaked")
+						close(leakDone)
+					}), NodeTimeout(time.Millisecond*50))
+					It("B", func() {
+						writer.Println("B-output")
+						close(release)
+						<-leakDone
+						rt.Run("B")
+					})
+				})
+				It("C", rt.T("C"))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("does not attribute the abandoned goroutine to the spec its worker runs next", func() {
+			Ω(rt.TrackedRuns()).Should(ConsistOf("A", "leaked", "B", "C"))
+			Ω(reporter.Did.Find("A").State).Should(Equal(types.SpecStateTimedout))
+			Ω(reporter.Did.Find("B")).Should(HavePassed())
+			Ω(reporter.Did.Find("B").CapturedGinkgoWriterOutput).Should(Equal("B-output\n"))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(BeEmpty())
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(3), NPassed(2), NFailed(1)))
+		})
+	})
+
+	Describe("calling into Ginkgo from a goroutine that can't be attributed to a spec", func() {
+		var pushErr error
+		BeforeEach(func() {
+			proceed, done := make(chan interface{}), make(chan interface{})
+			success, _ := RunFixture("goroutines", func() {
+				BeforeSuite(func() {
+					go func() {
+						<-proceed
+						rt.RunWithData("orphaned", "report", CurrentSpecReport())
+						node, errors := internal.NewNode(types.NewDeprecationTracker(), types.NodeTypeIt, "orphaned-it", func() {}, cl)
+						Ω(errors).Should(BeEmpty())
+						pushErr = global.Suite.PushNode(node)
+						failer.Fail("orphaned-failure", cl)
+						close(done)
+					}()
+				})
+				It("A", func() {
+					close(proceed)
+					<-done
+				})
+				It("B", rt.T("B"))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("fails the suite", func() {
+			Ω(reporter.Did.Find("A")).Should(HavePassed())
+			Ω(rt).Should(HaveRunWithData("orphaned", "report", types.SpecReport{}))
+			Ω(reporter.End.SuiteSucceeded).Should(BeFalse())
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(HaveLen(2))
+			Ω(reporter.End.SpecialSuiteFailureReasons[0]).Should(ContainSubstring("Ginkgo could not determine which spec is running"))
+			Ω(reporter.End.SpecialSuiteFailureReasons[0]).Should(ContainSubstring("CurrentSpecReport"))
+		})
+
+		It("names the node that could not be pushed", func() {
+			Ω(pushErr).Should(MatchError(types.GinkgoErrors.UnattributableGoroutineInGoroutineParallelMode("It", cl)))
+		})
+
+		It("keeps the message of failures that could not be attributed", func() {
+			Ω(reporter.End.SpecialSuiteFailureReasons[1]).Should(Equal(types.GinkgoErrors.UnattributableFailureInGoroutineParallelMode("Fail", "orphaned-failure", cl).Error()))
+			Ω(reporter.End.SpecialSuiteFailureReasons[1]).Should(ContainSubstring("orphaned-failure"))
+		})
+	})
+
+	Context("when there is only one goroutine", func() {
+		BeforeEach(func() {
+			conf.ParallelGoroutines = 1
+			success, _ := RunFixture("goroutines", func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs the specs in series, as usual", func() {
+			Ω(rt).Should(HaveTracked("A", "B"))
+			Ω(reporter.Will.Names()).Should(Equal([]string{"A", "B"}))
+		})
+	})
+})
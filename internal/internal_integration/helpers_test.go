@@ -0,0 +1,83 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Marking helper functions with GinkgoHelper", func() {
+	var clForNode, clForFail, clForSkip, clForGinkgoT types.CodeLocation
+
+	BeforeEach(func() {
+		sharedIt := func(text string) {
+			GinkgoHelper()
+			It(text, rt.T(text))
+		}
+
+		expectEven := func(n int) {
+			GinkgoHelper()
+			if n%2 != 0 {
+				Fail("not even")
+			}
+		}
+
+		skipIfOdd := func(n int) {
+			GinkgoHelper()
+			if n%2 != 0 {
+				Skip("odd")
+			}
+		}
+
+		nestedHelper := func(n int) {
+			GinkgoHelper()
+			skipIfOdd(n)
+		}
+
+		tHelper := func() {
+			GinkgoT().Helper()
+			Fail("from a T helper")
+		}
+
+		success, _ := RunFixture("helpers", func() {
+			clForNode = types.NewCodeLocation(0)
+			sharedIt("A")
+			It("B", func() {
+				clForFail = types.NewCodeLocation(0)
+				expectEven(3)
+			})
+			It("C", func() {
+				clForSkip = types.NewCodeLocation(0)
+				nestedHelper(3)
+			})
+			It("D", func() {
+				clForGinkgoT = types.NewCodeLocation(0)
+				tHelper()
+			})
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("attributes nodes defined in helpers to the helper's caller", func() {
+		Ω(reporter.Did.Find("A")).Should(HavePassed())
+		Ω(reporter.Did.Find("A").LeafNodeLocation.FileName).Should(Equal(clForNode.FileName))
+		Ω(reporter.Did.Find("A").LeafNodeLocation.LineNumber).Should(Equal(clForNode.LineNumber + 1))
+	})
+
+	It("attributes failures in helpers to the helper's caller", func() {
+		Ω(reporter.Did.Find("B")).Should(HaveFailed("not even"))
+		Ω(reporter.Did.Find("B").Failure.Location.FileName).Should(Equal(clForFail.FileName))
+		Ω(reporter.Did.Find("B").Failure.Location.LineNumber).Should(Equal(clForFail.LineNumber + 1))
+	})
+
+	It("skips nested helpers", func() {
+		Ω(reporter.Did.Find("C")).Should(HaveBeenSkippedWithMessage("odd"))
+		Ω(reporter.Did.Find("C").Failure.Location.LineNumber).Should(Equal(clForSkip.LineNumber + 1))
+	})
+
+	It("treats GinkgoT().Helper() like GinkgoHelper()", func() {
+		Ω(reporter.Did.Find("D")).Should(HaveFailed("from a T helper"))
+		Ω(reporter.Did.Find("D").Failure.Location.LineNumber).Should(Equal(clForGinkgoT.LineNumber + 1))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"io"
 	"reflect"
 	"testing"
+	"time"
 
 	. "github.com/onsi-experimental/ginkgo/v2"
 	"github.com/onsi-experimental/ginkgo/v2/internal"
@@ -27,6 +28,7 @@ var rt *RunTracker
 var cl types.CodeLocation
 var interruptHandler *FakeInterruptHandler
 var outputInterceptor *FakeOutputInterceptor
+var progressSignalRegistrar *FakeProgressSignalRegistrar
 
 var server parallel_support.Server
 var client parallel_support.Client
@@ -44,10 +46,12 @@ var _ = BeforeEach(func() {
 	DeferCleanup(interruptHandler.Stop)
 
 	outputInterceptor = NewFakeOutputInterceptor()
+	progressSignalRegistrar = NewFakeProgressSignalRegistrar()
 
 	conf.ParallelTotal = 1
 	conf.ParallelProcess = 1
 	conf.RandomSeed = 17
+	conf.GracePeriod = time.Second
 
 	server, client, exitChannels = nil, nil, nil
 })
@@ -74,7 +78,7 @@ func RunFixture(description string, callback func()) (bool, bool) {
 	WithSuite(suite, func() {
 		callback()
 		Ω(suite.BuildTree()).Should(Succeed())
-		success, hasProgrammaticFocus = suite.Run(description, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, conf)
+		success, hasProgrammaticFocus = suite.Run(description, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, progressSignalRegistrar.RegisterForProgressSignal, conf)
 	})
 	return success, hasProgrammaticFocus
 }
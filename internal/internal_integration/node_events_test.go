@@ -0,0 +1,72 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Emitting node events to reporters", func() {
+	BeforeEach(func() {
+		success, _ := RunFixture("node events", func() {
+			BeforeSuite(rt.T("before-suite"))
+			Describe("container", func() {
+				BeforeEach(rt.T("bef", func() {
+					time.Sleep(50 * time.Millisecond)
+					DeferCleanup(rt.T("cleanup"))
+				}))
+				It("A", rt.T("A"))
+				It("B", rt.T("B", func() {
+					F("fail")
+				}))
+				AfterEach(rt.T("aft"))
+			})
+			ReportAfterEach(func(_ SpecReport) {
+				rt.Run("report-after-each")
+			})
+			ReportAfterSuite("", func(_ Report) {
+				rt.Run("report-after-suite")
+			})
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("emits a NodeWillRun and a NodeDidRun event for every node that runs, in order", func() {
+		specNodes := []types.NodeType{types.NodeTypeBeforeEach, types.NodeTypeIt, types.NodeTypeAfterEach, types.NodeTypeCleanupAfterEach, types.NodeTypeReportAfterEach}
+		expected := []types.NodeType{types.NodeTypeBeforeSuite}
+		expected = append(expected, specNodes...)
+		expected = append(expected, specNodes...)
+		expected = append(expected, types.NodeTypeReportAfterSuite)
+
+		Ω(reporter.WillNodes.Types()).Should(Equal(expected))
+		Ω(reporter.DidNodes.Types()).Should(Equal(expected))
+	})
+
+	It("identifies the node and the spec it belongs to", func() {
+		Ω(reporter.WillNodes[0].Report.LeafNodeType).Should(Equal(types.NodeTypeBeforeSuite))
+		Ω(reporter.WillNodes[1].Report.LeafNodeText).Should(Equal("A"))
+		Ω(reporter.WillNodes[2].Event.NodeText).Should(Equal("A"))
+		Ω(reporter.WillNodes[2].Event.NodeLocation).Should(Equal(reporter.Did.Find("A").LeafNodeLocation))
+		Ω(reporter.WillNodes[2].Event.ParallelProcess).Should(Equal(1))
+		Ω(reporter.WillNodes[2].Event.State).Should(Equal(types.SpecStateInvalid))
+		Ω(reporter.WillNodes[2].Event.EndTime).Should(BeZero())
+		Ω(reporter.WillNodes[6].Report.LeafNodeText).Should(Equal("B"))
+	})
+
+	It("includes the duration and outcome of the node", func() {
+		beforeEachEvent := reporter.DidNodes[1].Event
+		Ω(beforeEachEvent.State).Should(Equal(types.SpecStatePassed))
+		Ω(beforeEachEvent.Failure).Should(BeZero())
+		Ω(beforeEachEvent.RunTime).Should(BeNumerically(">=", 50*time.Millisecond))
+		Ω(beforeEachEvent.EndTime.Sub(beforeEachEvent.StartTime)).Should(Equal(beforeEachEvent.RunTime))
+		Ω(beforeEachEvent.StartTime).Should(Equal(reporter.WillNodes[1].Event.StartTime))
+
+		itEvent := reporter.DidNodes[7].Event
+		Ω(itEvent.NodeText).Should(Equal("B"))
+		Ω(itEvent.State).Should(Equal(types.SpecStateFailed))
+		Ω(itEvent.Failure.Message).Should(Equal("fail"))
+		Ω(itEvent.Failure.FailureNodeType).Should(Equal(types.NodeTypeIt))
+	})
+})
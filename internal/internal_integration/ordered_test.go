@@ -853,4 +853,131 @@ var _ = DescribeTable("Ordered Containers",
 	},
 		"A", "B", "C", "D", "E", HavePassed(),
 	),
+	// OncePerOrdered setup nodes
+	Entry("OncePerOrdered setup nodes that wrap an ordered container run once for the container", true, func() {
+		Describe("outer", func() {
+			BeforeEach(rt.T("BE-once", DC("DC-once")), OncePerOrdered)
+			BeforeEach(rt.T("BE"))
+			JustBeforeEach(rt.T("JBE-once"), OncePerOrdered)
+			AfterEach(rt.T("AE"))
+			AfterEach(rt.T("AE-once"), OncePerOrdered)
+			Context("container", Ordered, func() {
+				BeforeEach(rt.T("BE-inner"), OncePerOrdered)
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+			})
+		})
+	}, []string{
+		"BE-once", "BE", "BE-inner", "JBE-once", "A", "AE",
+		"BE", "BE-inner", "B", "AE", "AE-once", "DC-once",
+	},
+		"A", "B", HavePassed(),
+	),
+	Entry("OncePerOrdered setup nodes behave normally outside of ordered containers", true, func() {
+		Describe("outer", func() {
+			BeforeEach(rt.T("BE-once", DC("DC-once")), OncePerOrdered)
+			AfterEach(rt.T("AE-once"), OncePerOrdered)
+			It("A", rt.T("A"))
+			It("B", rt.T("B"))
+		})
+	}, []string{
+		"BE-once", "A", "AE-once", "DC-once",
+		"BE-once", "B", "AE-once", "DC-once",
+	},
+		"A", "B", HavePassed(),
+	),
+	Entry("when a OncePerOrdered setup node fails, it runs the OncePerOrdered teardown nodes and skips the container", false, func() {
+		Describe("outer", func() {
+			BeforeEach(rt.T("BE-once", func() { F("fail") }), OncePerOrdered)
+			AfterEach(rt.T("AE-once"), OncePerOrdered)
+			Context("container", Ordered, func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+			})
+		})
+	}, []string{"BE-once", "AE-once"},
+		"A", HaveFailed("fail"),
+		"B", HaveBeenSkippedWithMessage(SKIP_DUE_TO_EARLIER_FAILURE),
+	),
+	Entry("when a OncePerOrdered setup node is skipped, it skips the container", true, func() {
+		Describe("outer", func() {
+			BeforeEach(rt.T("BE-once", func() { Skip("skip") }), OncePerOrdered)
+			AfterEach(rt.T("AE-once"), OncePerOrdered)
+			Context("container", Ordered, func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+			})
+		})
+	}, []string{"BE-once", "AE-once"},
+		"A", HaveBeenSkippedWithMessage("skip"),
+		"B", HaveBeenSkippedWithMessage("Spec skipped because Skip() was called in BeforeEach"),
+	),
+	Entry("when a OncePerOrdered setup node is flakey, it reruns it and its teardown", true, func() {
+		Describe("outer", func() {
+			BeforeEach(rt.T("BE-once", FlakeyFailer(1)), OncePerOrdered)
+			AfterEach(rt.T("AE-once"), OncePerOrdered)
+			Context("container", Ordered, FlakeAttempts(3), func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+			})
+		})
+	}, []string{"BE-once", "AE-once", "BE-once", "A", "B", "AE-once"},
+		"A", HavePassed(NumAttempts(2)),
+		"B", HavePassed(NumAttempts(1)),
+	),
+	// ContinueOnFailure
+	Entry("with ContinueOnFailure, subsequent specs run after a failure and the Alls run once", false, func() {
+		Context("container", Ordered, ContinueOnFailure, func() {
+			BeforeAll(rt.T("BA", DC("DC-BA")))
+			It("A", rt.T("A"))
+			It("B", rt.T("B", func() { F("fail") }))
+			It("C", rt.T("C"))
+			AfterEach(rt.T("AE"))
+			AfterAll(rt.T("AA"))
+		})
+	}, []string{"BA", "A", "AE", "B", "AE", "C", "AE", "AA", "DC-BA"},
+		"A", "C", HavePassed(),
+		"B", HaveFailed("fail"),
+	),
+	Entry("with ContinueOnFailure, a failure in a nested container does not run the Alls early", false, func() {
+		Context("container", Ordered, ContinueOnFailure, func() {
+			It("A", rt.T("A"))
+			Context("inner", func() {
+				BeforeAll(rt.T("BA-I"))
+				It("B", rt.T("B", func() { F("fail") }))
+				It("C", rt.T("C"))
+				AfterAll(rt.T("AA-I"))
+			})
+			It("D", rt.T("D"))
+			AfterAll(rt.T("AA-O"))
+		})
+	}, []string{"A", "BA-I", "B", "C", "AA-I", "D", "AA-O"},
+		"A", "C", "D", HavePassed(),
+		"B", HaveFailed("fail"),
+	),
+	Entry("with ContinueOnFailure, a failure in a BeforeAll still skips subsequent specs", false, func() {
+		Context("container", Ordered, ContinueOnFailure, func() {
+			BeforeAll(rt.T("BA", func() { F("fail") }))
+			It("A", rt.T("A"))
+			It("B", rt.T("B"))
+			AfterEach(rt.T("AE"))
+			AfterAll(rt.T("AA"))
+		})
+	}, []string{"BA", "AE", "AA"},
+		"A", HaveFailed("fail"),
+		"B", HaveBeenSkippedWithMessage(SKIP_DUE_TO_EARLIER_FAILURE),
+	),
+	Entry("with ContinueOnFailure, a failure in a OncePerOrdered setup node still skips subsequent specs", false, func() {
+		Describe("outer", func() {
+			BeforeEach(rt.T("BE-once", func() { F("fail") }), OncePerOrdered)
+			AfterEach(rt.T("AE-once"), OncePerOrdered)
+			Context("container", Ordered, ContinueOnFailure, func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+			})
+		})
+	}, []string{"BE-once", "AE-once"},
+		"A", HaveFailed("fail"),
+		"B", HaveBeenSkippedWithMessage(SKIP_DUE_TO_EARLIER_FAILURE),
+	),
 )
@@ -0,0 +1,214 @@
+package internal_integration_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	"github.com/onsi-experimental/ginkgo/v2/internal"
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi-experimental/ginkgo/v2/reporters"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Progress Reporting", func() {
+	Context("when a progress signal is received while a spec is running", func() {
+		var itCL types.CodeLocation
+		BeforeEach(func() {
+			itCL = types.NewCodeLocation(0)
+			success, _ := RunFixture("progress signal", func() {
+				Describe("a container", func() {
+					BeforeEach(rt.T("bef", func() {
+						By("setting up")
+					}))
+
+					It("A", itCL, rt.T("A", func() {
+						for i := 0; i < 20; i++ {
+							fmt.Fprintf(writer, "line %d\n", i)
+						}
+						By("signaling")
+						progressSignalRegistrar.Signal()
+					}))
+				})
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("emits a progress report describing the running spec and node", func() {
+			Ω(reporter.ProgressReports).Should(HaveLen(1))
+			pr := reporter.ProgressReports[0]
+			Ω(pr.RunningInParallel).Should(BeFalse())
+			Ω(pr.ContainerHierarchyTexts).Should(Equal([]string{"a container"}))
+			Ω(pr.LeafNodeText).Should(Equal("A"))
+			Ω(pr.LeafNodeLocation).Should(Equal(itCL))
+			Ω(pr.CurrentNodeType).Should(Equal(types.NodeTypeIt))
+			Ω(pr.CurrentNodeLocation).Should(Equal(itCL))
+			Ω(pr.SpecStartTime).ShouldNot(BeZero())
+			Ω(pr.CurrentNodeStartTime).Should(BeTemporally(">=", pr.SpecStartTime))
+		})
+
+		It("includes the most recent By step", func() {
+			pr := reporter.ProgressReports[0]
+			Ω(pr.CurrentStepText).Should(Equal("signaling"))
+			Ω(pr.CurrentStepLocation.FileName).Should(HaveSuffix("progress_report_test.go"))
+			Ω(pr.CurrentStepStartTime).Should(BeTemporally(">=", pr.CurrentNodeStartTime))
+		})
+
+		It("includes the tail of the GinkgoWriter output", func() {
+			pr := reporter.ProgressReports[0]
+			Ω(pr.CapturedGinkgoWriterOutput).Should(HavePrefix("line 10\n"))
+			Ω(pr.CapturedGinkgoWriterOutput).Should(HaveSuffix("line 19"))
+		})
+
+		It("includes only the stack of the goroutine running the node", func() {
+			pr := reporter.ProgressReports[0]
+			Ω(pr.SpecGoroutineStack).Should(HavePrefix("goroutine "))
+			Ω(pr.SpecGoroutineStack).Should(ContainSubstring("progress_report_test.go"))
+			Ω(pr.SpecGoroutineStack).ShouldNot(ContainSubstring("\n\ngoroutine "))
+		})
+	})
+
+	Context("when a By step was emitted by an earlier spec", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("stale step", func() {
+				It("A", func() {
+					By("a step in A")
+				})
+				It("B", func() {
+					progressSignalRegistrar.Signal()
+				})
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("does not include the step", func() {
+			Ω(reporter.ProgressReports).Should(HaveLen(1))
+			Ω(reporter.ProgressReports[0].LeafNodeText).Should(Equal("B"))
+			Ω(reporter.ProgressReports[0].CurrentStepText).Should(BeZero())
+		})
+	})
+
+	Context("when a node runs longer than PollProgressAfter", func() {
+		BeforeEach(func() {
+			conf.PollProgressInterval = 50 * time.Millisecond
+			success, _ := RunFixture("poll progress after", func() {
+				BeforeEach(func() {
+					time.Sleep(60 * time.Millisecond)
+				}, PollProgressAfter(time.Hour))
+				It("A", func() {
+					time.Sleep(130 * time.Millisecond)
+				}, PollProgressAfter(20*time.Millisecond))
+				It("B", func() {})
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("emits progress reports periodically until the node completes", func() {
+			Ω(len(reporter.ProgressReports)).Should(BeNumerically(">=", 2))
+			for _, pr := range reporter.ProgressReports {
+				Ω(pr.LeafNodeText).Should(Equal("A"))
+				Ω(pr.CurrentNodeType).Should(Equal(types.NodeTypeIt))
+			}
+		})
+	})
+
+	Context("when --poll-progress-after is set", func() {
+		BeforeEach(func() {
+			conf.PollProgressAfter = 20 * time.Millisecond
+			success, _ := RunFixture("poll progress after config", func() {
+				BeforeEach(func() {
+					time.Sleep(60 * time.Millisecond)
+				})
+				It("A", func() {})
+				It("B", func() {}, PollProgressAfter(time.Hour))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("applies it to all nodes that don't override it", func() {
+			Ω(reporter.ProgressReports).Should(HaveLen(2))
+			Ω(reporter.ProgressReports[0].LeafNodeText).Should(Equal("A"))
+			Ω(reporter.ProgressReports[0].CurrentNodeType).Should(Equal(types.NodeTypeBeforeEach))
+			Ω(reporter.ProgressReports[1].LeafNodeText).Should(Equal("B"))
+			Ω(reporter.ProgressReports[1].CurrentNodeType).Should(Equal(types.NodeTypeBeforeEach))
+		})
+	})
+
+	Context("when the reporter does not implement reporters.ProgressReporter", func() {
+		It("runs the suite without emitting progress reports", func() {
+			suite := internal.NewSuite()
+			WithSuite(suite, func() {
+				It("A", rt.T("A", progressSignalRegistrar.Signal))
+				Ω(suite.BuildTree()).Should(Succeed())
+			})
+			success, _ := suite.Run("suite", "/path/to/suite", failer, reporters.NoopReporter{}, writer, outputInterceptor, interruptHandler, client, progressSignalRegistrar.RegisterForProgressSignal, conf)
+			Ω(success).Should(BeTrue())
+			Ω(rt).Should(HaveTracked("A"))
+			Ω(reporter.ProgressReports).Should(BeEmpty())
+		})
+	})
+
+	Context("when running in parallel", func() {
+		var reporter2 *FakeReporter
+		BeforeEach(func() {
+			originalInterval := internal.PROGRESS_REPORT_REQUEST_POLLING_INTERVAL
+			internal.PROGRESS_REPORT_REQUEST_POLLING_INTERVAL = 10 * time.Millisecond
+			DeferCleanup(func() {
+				internal.PROGRESS_REPORT_REQUEST_POLLING_INTERVAL = originalInterval
+			})
+
+			SetUpForParallel(2)
+			conf2 := conf
+			conf2.ParallelProcess = 2
+
+			gate := make(chan interface{})
+			fixture := func() {
+				It("A", rt.T("A", func() { <-gate }))
+				It("B", rt.T("B", func() { <-gate }))
+			}
+
+			suite1, suite2 := internal.NewSuite(), internal.NewSuite()
+			WithSuite(suite1, func() {
+				fixture()
+				Ω(suite1.BuildTree()).Should(Succeed())
+			})
+			WithSuite(suite2, func() {
+				fixture()
+				Ω(suite2.BuildTree()).Should(Succeed())
+			})
+
+			finished := make(chan bool)
+			exit1, exit2 := exitChannels[1], exitChannels[2]
+			reporter2 = &FakeReporter{}
+			go func() {
+				success, _ := suite1.Run("proc 1", "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, progressSignalRegistrar.RegisterForProgressSignal, conf)
+				finished <- success
+				close(exit1)
+			}()
+			go func() {
+				success, _ := suite2.Run("proc 2", "/path/to/suite", internal.NewFailer(), reporter2, writer, outputInterceptor, interruptHandler, client, NewFakeProgressSignalRegistrar().RegisterForProgressSignal, conf2)
+				finished <- success
+				close(exit2)
+			}()
+
+			Eventually(rt.TrackedRuns).Should(ConsistOf("A", "B"))
+			progressSignalRegistrar.Signal()
+			Eventually(reporter.EmittedProgressReports).Should(HaveLen(1))
+			Eventually(reporter2.EmittedProgressReports).Should(HaveLen(1))
+			close(gate)
+
+			Eventually(finished).Should(Receive(Equal(true)))
+			Eventually(finished).Should(Receive(Equal(true)))
+		})
+
+		It("fans the request out to every process", func() {
+			pr1, pr2 := reporter.EmittedProgressReports()[0], reporter2.EmittedProgressReports()[0]
+			Ω(pr1.RunningInParallel).Should(BeTrue())
+			Ω(pr1.ParallelProcess).Should(Equal(1))
+			Ω(pr2.RunningInParallel).Should(BeTrue())
+			Ω(pr2.ParallelProcess).Should(Equal(2))
+			Ω([]string{pr1.LeafNodeText, pr2.LeafNodeText}).Should(ConsistOf("A", "B"))
+		})
+	})
+})
@@ -0,0 +1,147 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sending a pre-run report to ReportBeforeSuite nodes", func() {
+	var failInReportBeforeSuite bool
+	var fixture func()
+
+	BeforeEach(func() {
+		failInReportBeforeSuite = false
+		conf.LabelFilter = "!slow"
+		fixture = func() {
+			BeforeSuite(rt.T("before-suite"))
+			ReportBeforeSuite(func(report Report) {
+				rt.RunWithData("report-before-suite", "report", report)
+				writer.Print("gw-report-before-suite")
+				outputInterceptor.AppendInterceptedOutput("out-report-before-suite")
+				if failInReportBeforeSuite {
+					F("fail in report-before-suite")
+				}
+			})
+			Context("container", Label("cat"), func() {
+				It("A", Label("dog"), rt.T("A"))
+				It("B", Label("slow"), rt.T("B"))
+				PIt("C", rt.T("C"))
+			})
+			AfterSuite(rt.T("after-suite"))
+		}
+	})
+
+	Context("when running in series", func() {
+		BeforeEach(func() {
+			conf.ParallelTotal = 1
+			conf.ParallelProcess = 1
+		})
+
+		Context("the happy path", func() {
+			BeforeEach(func() {
+				success, _ := RunFixture("happy-path", fixture)
+				Ω(success).Should(BeTrue())
+			})
+
+			It("runs the ReportBeforeSuite node before the BeforeSuite node and any specs", func() {
+				Ω(rt).Should(HaveTracked(
+					"report-before-suite",
+					"before-suite",
+					"A",
+					"after-suite",
+				))
+			})
+
+			It("reports on the ReportBeforeSuite node", func() {
+				Ω(reporter.Did.FindByLeafNodeType(types.NodeTypeReportBeforeSuite)).Should(HavePassed(
+					CapturedGinkgoWriterOutput("gw-report-before-suite"),
+					CapturedStdOutput("out-report-before-suite"),
+				))
+			})
+
+			It("passes in a report that includes every spec in the suite", func() {
+				report := rt.DataFor("report-before-suite")["report"].(types.Report)
+				Ω(report.SuiteDescription).Should(Equal("happy-path"))
+				Ω(report.PreRunStats.TotalSpecs).Should(Equal(3))
+				Ω(report.PreRunStats.SpecsThatWillRun).Should(Equal(1))
+				Ω(report.SpecReports).Should(HaveLen(3))
+
+				reports := Reports(report.SpecReports)
+				specA := reports.Find("A")
+				Ω(specA.State).Should(Equal(types.SpecStateInvalid))
+				Ω(specA.ContainerHierarchyTexts).Should(Equal([]string{"container"}))
+				Ω(specA.Labels()).Should(Equal([]string{"cat", "dog"}))
+				Ω(specA.LeafNodeType).Should(Equal(types.NodeTypeIt))
+				Ω(specA.LeafNodeLocation).Should(Equal(reporter.Did.Find("A").LeafNodeLocation))
+
+				Ω(reports.Find("B")).Should(HaveBeenSkipped())
+				Ω(reports.Find("C")).Should(BePending())
+			})
+		})
+
+		Context("when the ReportBeforeSuite node fails", func() {
+			BeforeEach(func() {
+				failInReportBeforeSuite = true
+				success, _ := RunFixture("report-before-suite-fails", fixture)
+				Ω(success).Should(BeFalse())
+			})
+
+			It("does not run any specs", func() {
+				Ω(rt).Should(HaveTracked(
+					"report-before-suite",
+					"before-suite",
+					"after-suite",
+				))
+			})
+
+			It("reports on the failure", func() {
+				Ω(reporter.Did.FindByLeafNodeType(types.NodeTypeReportBeforeSuite)).Should(HaveFailed(
+					"fail in report-before-suite",
+					CapturedGinkgoWriterOutput("gw-report-before-suite"),
+				))
+			})
+		})
+	})
+
+	Context("when running in parallel", func() {
+		BeforeEach(func() {
+			SetUpForParallel(2)
+		})
+
+		Context("on proc 1", func() {
+			BeforeEach(func() {
+				conf.ParallelProcess = 1
+				success, _ := RunFixture("happy-path", fixture)
+				Ω(success).Should(BeTrue())
+			})
+
+			It("runs the ReportBeforeSuite node", func() {
+				Ω(rt).Should(HaveTracked(
+					"report-before-suite",
+					"before-suite",
+					"A",
+					"after-suite",
+				))
+			})
+		})
+
+		Context("on a non-primary proc", func() {
+			BeforeEach(func() {
+				conf.ParallelProcess = 2
+				success, _ := RunFixture("happy-path", fixture)
+				Ω(success).Should(BeTrue())
+			})
+
+			It("does not run the ReportBeforeSuite node", func() {
+				Ω(rt).Should(HaveTracked(
+					"before-suite",
+					"A",
+					"after-suite",
+				))
+				Ω(reporter.Did.FindByLeafNodeType(types.NodeTypeReportBeforeSuite)).Should(BeZero())
+			})
+		})
+	})
+})
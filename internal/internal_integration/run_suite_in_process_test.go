@@ -0,0 +1,109 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	"github.com/onsi-experimental/ginkgo/v2/internal/interrupt_handler"
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Running suites in-process", func() {
+	var suiteConfig types.SuiteConfig
+	var reporterConfig types.ReporterConfig
+
+	BeforeEach(func() {
+		suiteConfig = types.NewDefaultSuiteConfig()
+		suiteConfig.RandomSeed = 17
+		reporterConfig = types.NewDefaultReporterConfig()
+		reporterConfig.NoColor = true
+	})
+
+	It("builds and runs the tree in isolation and returns the suite report", func() {
+		var didRunBeforeSuite bool
+		report, err := RunSuiteInProcess("in-process suite", func() {
+			BeforeSuite(func() {
+				didRunBeforeSuite = true
+			})
+			Describe("container", Label("cat"), func() {
+				It("passes", func() {
+					GinkgoWriter.Println("gw-passes")
+					AddReportEntry("entry", 17)
+				})
+				It("fails", func() {
+					Fail("boom")
+				})
+				It("skips", func() {
+					Skip("skipping")
+				})
+				PIt("is pending")
+			})
+		}, suiteConfig, reporterConfig)
+
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(didRunBeforeSuite).Should(BeTrue())
+		Ω(report.SuiteDescription).Should(Equal("in-process suite"))
+		Ω(report.SuiteSucceeded).Should(BeFalse())
+		Ω(report.SuiteConfig.RandomSeed).Should(Equal(int64(17)))
+
+		reports := Reports(report.SpecReports)
+		Ω(reports.Find("passes")).Should(HavePassed(CapturedGinkgoWriterOutput("gw-passes\n")))
+		Ω(reports.Find("passes").ReportEntries[0].Name).Should(Equal("entry"))
+		Ω(reports.Find("passes").ContainerHierarchyLabels).Should(Equal([][]string{{"cat"}}))
+		Ω(reports.Find("fails")).Should(HaveFailed("boom"))
+		Ω(reports.Find("skips")).Should(HaveBeenSkippedWithMessage("skipping"))
+		Ω(reports.Find("is pending")).Should(BePending())
+		Ω(reports.FindByLeafNodeType(types.NodeTypeBeforeSuite)).Should(HavePassed())
+
+		Ω(CurrentSpecReport().LeafNodeText).Should(Equal("builds and runs the tree in isolation and returns the suite report"), "the global suite is restored")
+	})
+
+	It("honors the passed-in suite configuration", func() {
+		suiteConfig.LabelFilter = "dog"
+		report, err := RunSuiteInProcess("filtered suite", func() {
+			It("A", Label("dog"), func() {})
+			It("B", func() {})
+		}, suiteConfig, reporterConfig)
+
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(report.SuiteSucceeded).Should(BeTrue())
+		reports := Reports(report.SpecReports)
+		Ω(reports.Find("A")).Should(HavePassed())
+		Ω(reports.Find("B")).Should(HaveBeenSkipped())
+	})
+
+	It("interrupts the suite when the timeout elapses", func() {
+		suiteConfig.Timeout = 100 * time.Millisecond
+		report, err := RunSuiteInProcess("timeout suite", func() {
+			It("hangs", func(ctx SpecContext) {
+				<-ctx.Done()
+			})
+		}, suiteConfig, reporterConfig)
+
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(report.SuiteSucceeded).Should(BeFalse())
+		Ω(Reports(report.SpecReports).Find("hangs")).Should(HaveBeenInterrupted(interrupt_handler.InterruptCauseTimeout))
+	})
+
+	Describe("errors", func() {
+		It("returns tree construction errors instead of exiting", func() {
+			_, err := RunSuiteInProcess("invalid suite", func() {
+				Describe("container", func() {
+					It("A", func() {})
+					BeforeAll(func() {})
+				})
+			}, suiteConfig, reporterConfig)
+
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("BeforeAll"))
+		})
+
+		It("returns invalid configuration errors", func() {
+			suiteConfig.ParallelTotal = 2
+			_, err := RunSuiteInProcess("parallel suite", func() {}, suiteConfig, reporterConfig)
+			Ω(err).Should(MatchError(types.GinkgoErrors.InProcessSuiteInParallelConfiguration()))
+		})
+	})
+})
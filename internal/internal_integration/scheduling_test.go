@@ -0,0 +1,69 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scheduling parallel specs from previous run times", func() {
+	var fixture = func() {
+		It("A", rt.T("A"))
+		It("B", rt.T("B"))
+		It("C", rt.T("C"))
+		It("D", rt.T("D"))
+		Context("ordered", Ordered, func() {
+			It("OA", rt.T("OA"))
+			It("OB", rt.T("OB"))
+		})
+		It("E", SpecPriority(1), rt.T("E"))
+		It("S", Serial, rt.T("S"))
+	}
+
+	var specIDs map[string]string
+
+	BeforeEach(func() {
+		conf.RandomizeAllSpecs = true
+
+		// a first run with no run times tells us each spec's ID and the randomized order
+		SetUpForParallel(2)
+		close(exitChannels[2])
+		success, _ := RunFixture("first run", fixture)
+		Ω(success).Should(BeTrue())
+		specIDs = map[string]string{}
+		for _, report := range reporter.Did.WithLeafNodeType(types.NodeTypeIt) {
+			specIDs[report.LeafNodeText] = report.SpecID
+		}
+		Ω(rt.TrackedRuns()).Should(HaveLen(8))
+		Ω(rt.TrackedRuns()[0]).Should(Equal("E"))
+		Ω(rt.TrackedRuns()[7]).Should(Equal("S"))
+	})
+
+	It("runs the slowest groups first, respecting SpecPriority and estimating unknown specs at the median run time", func() {
+		randomizedOrder := []string{}
+		for _, run := range rt.TrackedRuns() {
+			if run == "B" || run == "D" {
+				randomizedOrder = append(randomizedOrder, run)
+			}
+		}
+
+		reporter, rt = &FakeReporter{}, NewRunTracker()
+		SetUpForParallel(2)
+		close(exitChannels[2])
+		server.SetSpecRunTimes(map[string]time.Duration{
+			specIDs["A"]: time.Second,
+			specIDs["C"]: 5 * time.Second,
+			specIDs["D"]: 2 * time.Second,
+			specIDs["E"]: time.Millisecond,
+			specIDs["S"]: time.Minute,
+		})
+		success, _ := RunFixture("second run", fixture)
+		Ω(success).Should(BeTrue())
+
+		// B, OA, and OB are estimated at the median run time (2s) - so the ordered container (4s) runs after C and B runs alongside D in the random order
+		Ω(rt.TrackedRuns()).Should(Equal(append(append([]string{"E", "C", "OA", "OB"}, randomizedOrder...), "A", "S")))
+	})
+})
@@ -0,0 +1,136 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recording By steps", func() {
+	var stepTexts = func(steps types.SpecSteps) []string {
+		texts := []string{}
+		for _, step := range steps {
+			texts = append(texts, step.Text)
+		}
+		return texts
+	}
+
+	var nestingLevels = func(steps types.SpecSteps) []int {
+		levels := []int{}
+		for _, step := range steps {
+			levels = append(levels, step.NestingLevel)
+		}
+		return levels
+	}
+
+	BeforeEach(func() {
+		success, _ := RunFixture("steps", func() {
+			BeforeEach(func() {
+				By("setting up")
+			})
+			It("A", func() {
+				By("first")
+				time.Sleep(20 * time.Millisecond)
+				By("outer", func() {
+					By("inner-1")
+					time.Sleep(20 * time.Millisecond)
+					By("inner-2", func() {
+						time.Sleep(20 * time.Millisecond)
+					})
+				})
+				By("last")
+			})
+			It("B", func() {
+				By("outer", func() {
+					By("inner")
+					F("fail")
+				})
+			})
+			It("C", func() {
+				By("step")
+				By("wrapped", func() {})
+				F("fail")
+			})
+			It("D", func() {
+				By("panicking step", func() {
+					panic("boom")
+				})
+			})
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("records every step, including nested steps, with its nesting level", func() {
+		steps := reporter.Did.Find("A").Steps
+		Ω(stepTexts(steps)).Should(Equal([]string{"setting up", "first", "outer", "inner-1", "inner-2", "last"}))
+		Ω(nestingLevels(steps)).Should(Equal([]int{0, 0, 0, 1, 1, 0}))
+		for _, step := range steps {
+			Ω(step.CodeLocation.FileName).Should(HaveSuffix("steps_test.go"))
+			Ω(step.StartTime).Should(BeTemporally(">=", reporter.Did.Find("A").StartTime))
+		}
+	})
+
+	It("records the duration of each step", func() {
+		steps := reporter.Did.Find("A").Steps
+		Ω(steps[1].Duration).Should(BeNumerically(">=", 20*time.Millisecond), "a step without a callback lasts until the next step")
+		Ω(steps[1].StartTime.Add(steps[1].Duration)).Should(BeTemporally("<=", steps[2].StartTime))
+		Ω(steps[2].Duration).Should(BeNumerically(">=", 40*time.Millisecond), "a step with a callback lasts until the callback returns")
+		Ω(steps[3].Duration).Should(BeNumerically(">=", 20*time.Millisecond))
+		Ω(steps[4].Duration).Should(BeNumerically(">=", 20*time.Millisecond))
+		Ω(steps[2].Duration).Should(BeNumerically(">=", steps[3].Duration+steps[4].Duration))
+	})
+
+	It("does not annotate passing specs", func() {
+		Ω(reporter.Did.Find("A")).Should(HavePassed())
+		Ω(reporter.Did.Find("A").Failure.FailureStepText).Should(BeEmpty())
+	})
+
+	It("annotates the failure with the innermost active step", func() {
+		Ω(reporter.Did.Find("B")).Should(HaveFailed("fail"))
+		Ω(reporter.Did.Find("B").Failure.FailureStepText).Should(Equal("inner"))
+		Ω(reporter.Did.Find("B").Failure.FailureStepLocation).Should(Equal(reporter.Did.Find("B").Steps[2].CodeLocation))
+		Ω(nestingLevels(reporter.Did.Find("B").Steps)).Should(Equal([]int{0, 0, 1}))
+
+		Ω(reporter.Did.Find("D")).Should(HavePanicked("boom"))
+		Ω(reporter.Did.Find("D").Failure.FailureStepText).Should(Equal("panicking step"))
+	})
+
+	It("does not annotate failures that occur once a step with a callback has returned", func() {
+		Ω(reporter.Did.Find("C")).Should(HaveFailed("fail"))
+		Ω(stepTexts(reporter.Did.Find("C").Steps)).Should(Equal([]string{"setting up", "step", "wrapped"}))
+		Ω(reporter.Did.Find("C").Failure.FailureStepText).Should(BeEmpty())
+	})
+
+	Context("when a node is abandoned", func() {
+		BeforeEach(func() {
+			release, leakDone := make(chan interface{}), make(chan interface{})
+			conf.GracePeriod = time.Millisecond * 50
+			success, _ := RunFixture("abandoned steps", func() {
+				It("abandoned", func(c SpecContext) {
+					By("abandoned-step", func() {
+						<-c.Done()
+						<-release
+						By("leaked-step")
+					})
+					close(leakDone)
+				}, NodeTimeout(time.Millisecond*50))
+				It("runs next", func() {
+					By("next-step", func() {
+						close(release)
+						<-leakDone
+					})
+				})
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("does not record steps from the abandoned node in the spec that is running", func() {
+			Ω(reporter.Did.Find("runs next")).Should(HavePassed())
+			Ω(stepTexts(reporter.Did.Find("runs next").Steps)).Should(Equal([]string{"next-step"}))
+			Ω(reporter.Did.Find("runs next").Steps[0].Duration).ShouldNot(BeZero())
+		})
+	})
+})
@@ -0,0 +1,102 @@
+package internal_integration_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+type tableCase struct {
+	A, B int
+}
+
+var _ = Describe("Type-safe table driven tests", func() {
+	var bodyFunc = func(c tableCase) {
+		rt.Run(CurrentSpecReport().LeafNodeText)
+		if c.A != c.B {
+			F("fail")
+		}
+	}
+
+	Describe("constructing tables", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("typed table happy-path", func() {
+				DescribeTableOf("hello", bodyFunc,
+					EntryOf("A", tableCase{1, 1}),
+					EntryOf("B", tableCase{1, 2}),
+					EntryOf(nil, tableCase{3, 3}),
+					EntryOf(EntryDescription("case %+v"), tableCase{4, 4}),
+					EntryOf(func(c tableCase) string { return fmt.Sprintf("%d vs %d", c.A, c.B) }, tableCase{5, 5}),
+					EntryOf(func(a, b int) string { return "invalid" }, tableCase{6, 6}),
+				)
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("runs all the entries, with the correct names", func() {
+			Ω(rt).Should(HaveTracked("A", "B", "Entry: {3 3}", "case {A:4 B:4}", "5 vs 5"))
+		})
+
+		It("catches invalid entry descriptions", func() {
+			Ω(reporter.Did.Find("")).Should(HavePanicked("Invalid Entry description"))
+		})
+
+		It("reports on the tests correctly", func() {
+			Ω(reporter.Did.Find("A").ContainerHierarchyTexts).Should(Equal([]string{"hello"}))
+			Ω(reporter.Did.Find("B")).Should(HaveFailed("fail", types.NodeTypeIt))
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(6), NPassed(4), NFailed(2)))
+		})
+	})
+
+	Describe("decorating entries", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("typed table with decorated entries", func() {
+				DescribeTableOf("hello", bodyFunc,
+					EntryOf("A", tableCase{1, 1}, Label("cat")),
+					PEntryOf("B", tableCase{1, 1}),
+					FEntryOf("C", tableCase{1, 2}),
+					EntryOf("D", tableCase{1, 1}, Focus, Label("dog")),
+					EntryOf("E", tableCase{1, 1}),
+				)
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("runs only the focused entries", func() {
+			Ω(rt).Should(HaveTracked("C", "D"))
+		})
+
+		It("reports on the tests correctly", func() {
+			Ω(reporter.Did.Find("A")).Should(HaveBeenSkipped())
+			Ω(reporter.Did.Find("A").LeafNodeLabels).Should(Equal([]string{"cat"}))
+			Ω(reporter.Did.Find("B")).Should(BePending())
+			Ω(reporter.Did.Find("C")).Should(HaveFailed("fail"))
+			Ω(reporter.Did.Find("D")).Should(HavePassed())
+			Ω(reporter.Did.Find("D").LeafNodeLabels).Should(Equal([]string{"dog"}))
+			Ω(reporter.Did.Find("E")).Should(HaveBeenSkipped())
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(5), NPassed(1), NFailed(1), NPending(1), NSkipped(2)))
+		})
+	})
+
+	Describe("when tables are marked pending or focused", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("typed tables marked pending and focused", func() {
+				PDescribeTableOf("pending", bodyFunc, EntryOf("A", tableCase{1, 1}))
+				FDescribeTableOf("focused", bodyFunc, EntryOf("B", tableCase{1, 1}))
+				DescribeTable("reflective", func(a, b int) { rt.Run("C") }, Entry("C", 1, 1))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("honors the table-level decorators and coexists with DescribeTable", func() {
+			Ω(rt).Should(HaveTracked("B"))
+			Ω(reporter.Did.Find("A")).Should(BePending())
+			Ω(reporter.Did.Find("B")).Should(HavePassed())
+			Ω(reporter.Did.Find("C")).Should(HaveBeenSkipped())
+		})
+	})
+})
@@ -441,4 +441,86 @@ var _ = Describe("Table driven tests", func() {
 			Ω(reporter.Did.Find("D")).Should(HavePassed(NumAttempts(3)))
 		})
 	})
+
+	Describe("DescribeTableSubtree", func() {
+		var subtreeBodyFunc = func(a, b int) {
+			BeforeEach(rt.T(fmt.Sprintf("bef-%d-%d", a, b)))
+			It(fmt.Sprintf("is-equal-%d-%d", a, b), func() {
+				rt.Run(CurrentSpecReport().LeafNodeText)
+				if a != b {
+					F("fail")
+				}
+			})
+			Context("nested", func() {
+				It(fmt.Sprintf("is-positive-%d", a), rt.T(fmt.Sprintf("is-positive-%d", a)))
+			})
+		}
+
+		Describe("constructing subtrees", func() {
+			BeforeEach(func() {
+				success, _ := RunFixture("subtree table happy-path", func() {
+					DescribeTableSubtree("hello", subtreeBodyFunc, Entry("A", 1, 1), Entry(nil, 1, 2))
+				})
+				Ω(success).Should(BeFalse())
+			})
+
+			It("runs all the specs generated by each entry", func() {
+				Ω(rt).Should(HaveTracked(
+					"bef-1-1", "is-equal-1-1", "bef-1-1", "is-positive-1",
+					"bef-1-2", "is-equal-1-2", "bef-1-2", "is-positive-1",
+				))
+			})
+
+			It("generates a container for each entry, honoring entry descriptions", func() {
+				Ω(reporter.Did.Find("is-equal-1-1").ContainerHierarchyTexts).Should(Equal([]string{"hello", "A"}))
+				Ω(reporter.Did.Find("is-equal-1-2").ContainerHierarchyTexts).Should(Equal([]string{"hello", "Entry: 1, 2"}))
+				Ω(reporter.Did.Find("is-equal-1-2")).Should(HaveFailed("fail", types.NodeTypeIt))
+				Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(4), NPassed(3), NFailed(1)))
+			})
+		})
+
+		Describe("decorating entries", func() {
+			BeforeEach(func() {
+				success, _ := RunFixture("subtree table with decorated entries", func() {
+					DescribeTableSubtree("hello", subtreeBodyFunc,
+						Entry("A", Label("cat"), 1, 1),
+						PEntry("B", 2, 2),
+						Entry("C", Focus, 3, 3),
+						Entry("D", 4, 4),
+					)
+				})
+				Ω(success).Should(BeTrue())
+			})
+
+			It("runs only the focused entries", func() {
+				Ω(rt).Should(HaveTracked("bef-3-3", "is-equal-3-3", "bef-3-3", "is-positive-3"))
+			})
+
+			It("applies the entry decorators to the generated container", func() {
+				Ω(reporter.Did.Find("is-equal-1-1").ContainerHierarchyLabels).Should(Equal([][]string{{}, {"cat"}}))
+				Ω(reporter.Did.Find("is-positive-1").ContainerHierarchyLabels).Should(Equal([][]string{{}, {"cat"}, {}}))
+				Ω(reporter.Did.Find("is-equal-1-1")).Should(HaveBeenSkipped())
+				Ω(reporter.Did.Find("is-equal-2-2")).Should(BePending())
+				Ω(reporter.Did.Find("is-positive-2")).Should(BePending())
+				Ω(reporter.Did.Find("is-equal-4-4")).Should(HaveBeenSkipped())
+				Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(8), NPassed(2), NPending(2), NSkipped(4)))
+			})
+		})
+
+		Describe("when the table is marked pending", func() {
+			BeforeEach(func() {
+				success, _ := RunFixture("pending subtree table", func() {
+					PDescribeTableSubtree("hello", subtreeBodyFunc, Entry("A", 1, 1))
+					It("runs", rt.T("runs"))
+				})
+				Ω(success).Should(BeTrue())
+			})
+
+			It("marks all the generated specs as pending", func() {
+				Ω(rt).Should(HaveTracked("runs"))
+				Ω(reporter.Did.Find("is-equal-1-1")).Should(BePending())
+				Ω(reporter.Did.Find("is-positive-1")).Should(BePending())
+			})
+		})
+	})
 })
@@ -0,0 +1,280 @@
+package internal_integration_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	"github.com/onsi-experimental/ginkgo/v2/internal"
+	"github.com/onsi-experimental/ginkgo/v2/internal/interrupt_handler"
+	. "github.com/onsi-experimental/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timeouts", func() {
+	Context("when a node has a NodeTimeout", func() {
+		var itCL types.CodeLocation
+		BeforeEach(func() {
+			itCL = types.NewCodeLocation(0)
+			tracker := rt //the abandoned node body must not track runs against subsequent tests' run trackers
+			success, _ := RunFixture("node timeout", func() {
+				BeforeEach(rt.T("BE"))
+				It("times out", itCL, rt.TSC("times-out", func(c SpecContext) {
+					<-c.Done()
+					tracker.Run("saw-cancellation")
+				}), NodeTimeout(time.Millisecond*50))
+				It("passes within the timeout", rt.TSC("passes", func(c SpecContext) {
+					Ω(c.Err()).Should(BeNil())
+					_, hasDeadline := c.Deadline()
+					Ω(hasDeadline).Should(BeTrue())
+				}), NodeTimeout(time.Second))
+				AfterEach(rt.T("AE"))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("cancels the context, runs the after nodes, and moves on to the next spec", func() {
+			Eventually(rt.TrackedRuns).Should(ConsistOf(
+				"BE", "times-out", "saw-cancellation", "AE",
+				"BE", "passes", "AE",
+			))
+			Ω(rt.TrackedRuns()[0:2]).Should(Equal([]string{"BE", "times-out"}))
+		})
+
+		It("reports the spec as timed out with the location of the node that timed out", func() {
+			specReport := reporter.Did.Find("times out")
+			Ω(specReport.State).Should(Equal(types.SpecStateTimedout))
+			Ω(specReport.Failure.Message).Should(Equal("A node timeout occurred"))
+			Ω(specReport.Failure.Location).Should(Equal(itCL))
+			Ω(specReport.Failure.FailureNodeType).Should(Equal(types.NodeTypeIt))
+
+			Ω(reporter.Did.Find("passes within the timeout")).Should(HavePassed())
+		})
+
+		It("reports the correct statistics", func() {
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(2), NPassed(1), NFailed(1)))
+		})
+	})
+
+	Context("when a spec has a SpecTimeout", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("spec timeout", func() {
+				BeforeEach(rt.TSC("BE", func(c SpecContext) {
+					_, hasDeadline := c.Deadline()
+					Ω(hasDeadline).Should(BeTrue())
+					time.Sleep(time.Millisecond * 30)
+				}))
+				It("times out", rt.TSC("times-out", func(c SpecContext) {
+					<-c.Done()
+				}), SpecTimeout(time.Millisecond*80))
+				AfterEach(rt.TSC("AE", func(c SpecContext) {
+					Ω(c.Err()).Should(BeNil())
+					_, hasDeadline := c.Deadline()
+					Ω(hasDeadline).Should(BeFalse())
+				}))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("applies the timeout to the spec as a whole, but not to the after nodes that run after the timeout", func() {
+			Ω(rt).Should(HaveTracked("BE", "times-out", "AE"))
+			specReport := reporter.Did.Find("times out")
+			Ω(specReport.State).Should(Equal(types.SpecStateTimedout))
+			Ω(specReport.Failure.Message).Should(Equal("A spec timeout occurred"))
+			Ω(specReport.Failure.FailureNodeType).Should(Equal(types.NodeTypeIt))
+			Ω(specReport.RunTime).Should(BeNumerically(">=", time.Millisecond*80))
+		})
+	})
+
+	Context("when a node has a NodeTimeout that is shorter than its SpecTimeout", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("node and spec timeout", func() {
+				It("times out", rt.TSC("times-out", func(c SpecContext) {
+					<-c.Done()
+				}), NodeTimeout(time.Millisecond*50), SpecTimeout(time.Hour))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("uses the earlier deadline", func() {
+			specReport := reporter.Did.Find("times out")
+			Ω(specReport.State).Should(Equal(types.SpecStateTimedout))
+			Ω(specReport.Failure.Message).Should(Equal("A node timeout occurred"))
+		})
+	})
+
+	Context("when a timed out spec has FlakeAttempts", func() {
+		BeforeEach(func() {
+			attempt := 0
+			success, _ := RunFixture("flakey timeout", func() {
+				It("times out and then passes", rt.TSC("A", func(c SpecContext) {
+					attempt += 1
+					if attempt == 1 {
+						<-c.Done()
+					}
+				}), NodeTimeout(time.Millisecond*50), FlakeAttempts(2))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("retries the spec", func() {
+			Ω(rt).Should(HaveTracked("A", "A"))
+			specReport := reporter.Did.Find("times out and then passes")
+			Ω(specReport).Should(HavePassed())
+			Ω(specReport.NumAttempts).Should(Equal(2))
+		})
+	})
+
+	Context("when DeferCleanup is handed a function that takes a context", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("cleanup timeout", func() {
+				It("registers a cleanup", rt.T("A", func() {
+					DeferCleanup(func(c context.Context, label string) {
+						rt.Run(label)
+						<-c.Done()
+					}, "cleanup", NodeTimeout(time.Millisecond*50))
+				}))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("passes the context in and honors the NodeTimeout", func() {
+			Ω(rt).Should(HaveTracked("A", "cleanup"))
+			specReport := reporter.Did.Find("registers a cleanup")
+			Ω(specReport.State).Should(Equal(types.SpecStateTimedout))
+			Ω(specReport.Failure.FailureNodeType).Should(Equal(types.NodeTypeCleanupAfterEach))
+		})
+	})
+
+	Context("when a suite-level node times out", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("before suite timeout", func() {
+				BeforeSuite(rt.TSC("BS", func(c SpecContext) {
+					<-c.Done()
+				}), NodeTimeout(time.Millisecond*50))
+				It("A", rt.T("A"))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("reports the timeout and skips the specs", func() {
+			Ω(rt).Should(HaveTracked("BS"))
+			specReport := reporter.Did.FindByLeafNodeType(types.NodeTypeBeforeSuite)
+			Ω(specReport.State).Should(Equal(types.SpecStateTimedout))
+			Ω(specReport.Failure.Message).Should(Equal("A node timeout occurred"))
+		})
+	})
+
+	Context("when a node that takes a context is interrupted", func() {
+		BeforeEach(func() {
+			tracker := rt
+			success, _ := RunFixture("interrupted context", func() {
+				It("is interrupted", rt.TSC("A", func(c SpecContext) {
+					interruptHandler.Interrupt(interrupt_handler.InterruptCauseSignal)
+					<-c.Done()
+					tracker.Run("saw-cancellation")
+				}))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("cancels the context and reports the spec as interrupted", func() {
+			Eventually(rt.TrackedRuns).Should(Equal([]string{"A", "saw-cancellation"}))
+			Ω(reporter.Did.Find("is interrupted").State).Should(Equal(types.SpecStateInterrupted))
+		})
+	})
+
+	Context("when a node exits within its grace period", func() {
+		BeforeEach(func() {
+			conf.GracePeriod = time.Hour
+			success, _ := RunFixture("exits within grace period", func() {
+				It("times out", rt.TSC("A", func(c SpecContext) {
+					<-c.Done()
+					time.Sleep(time.Millisecond * 20)
+					rt.Run("cleaned-up")
+				}), NodeTimeout(time.Millisecond*50), GracePeriod(time.Second))
+				It("B", rt.T("B"))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("waits for the node to exit before moving on, and honors the GracePeriod decorator", func() {
+			Ω(rt).Should(HaveTracked("A", "cleaned-up", "B"))
+			specReport := reporter.Did.Find("times out")
+			Ω(specReport.State).Should(Equal(types.SpecStateTimedout))
+			Ω(specReport.Failure.Message).Should(Equal("A node timeout occurred"))
+			Ω(reporter.Did.Find("B")).Should(HavePassed())
+		})
+	})
+
+	Context("when a node does not exit within its grace period", func() {
+		BeforeEach(func() {
+			tracker, leakyFailer := rt, failer
+			release, leakDone := make(chan interface{}), make(chan interface{})
+			conf.GracePeriod = time.Millisecond * 50
+			success, _ := RunFixture("ignores cancellation", func() {
+				It("times out", rt.TSC("A", func(c SpecContext) {
+					<-c.Done()
+					<-release
+					tracker.Run("leaked")
+					leakyFailer.Fail("failure from leaked goroutine", cl)
+					close(leakDone)
+				}), NodeTimeout(time.Millisecond*50))
+				It("B", rt.T("B", func() {
+					close(release)
+					<-leakDone
+				}))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("abandons the node and reports the goroutine's stack", func() {
+			Ω(rt).Should(HaveTracked("A", "B", "leaked"))
+			specReport := reporter.Did.Find("times out")
+			Ω(specReport.State).Should(Equal(types.SpecStateTimedout))
+			Ω(specReport.Failure.Message).Should(HavePrefix("A node timeout occurred"))
+			Ω(specReport.Failure.Message).Should(ContainSubstring("Node did not exit within grace period of 50ms"))
+			Ω(specReport.Failure.Message).Should(ContainSubstring("timeout_test.go"))
+		})
+
+		It("quarantines the abandoned goroutine so that it cannot fail subsequent specs", func() {
+			Ω(reporter.Did.Find("B")).Should(HavePassed())
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(2), NPassed(1), NFailed(1)))
+		})
+	})
+
+	Context("when a goroutine launched by an abandoned node fails", func() {
+		BeforeEach(func() {
+			if !internal.RuntimeReportsGoroutineLineage() {
+				Skip("this runtime does not report goroutine lineage")
+			}
+			tracker, leakyFailer := rt, failer
+			release, leakDone := make(chan interface{}), make(chan interface{})
+			conf.GracePeriod = time.Millisecond * 50
+			success, _ := RunFixture("leaks a goroutine", func() {
+				It("times out", rt.TSC("A", func(c SpecContext) {
+					go func() {
+						<-release
+						tracker.Run("leaked")
+						leakyFailer.Fail("failure from a goroutine launched by the abandoned node", cl)
+						close(leakDone)
+					}()
+					<-c.Done()
+					<-leakDone
+				}), NodeTimeout(time.Millisecond*50))
+				It("B", rt.T("B", func() {
+					close(release)
+					<-leakDone
+				}))
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("quarantines the goroutine too", func() {
+			Ω(rt).Should(HaveTracked("A", "B", "leaked"))
+			Ω(reporter.Did.Find("B")).Should(HavePassed())
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(2), NPassed(1), NFailed(1)))
+		})
+	})
+})
@@ -1,9 +1,11 @@
 package internal
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sort"
+	"time"
 
 	"sync"
 
@@ -27,7 +29,7 @@ type Node struct {
 	NodeType types.NodeType
 
 	Text         string
-	Body         func()
+	Body         func(SpecContext)
 	CodeLocation types.CodeLocation
 	NestingLevel int
 
@@ -37,15 +39,29 @@ type Node struct {
 	SynchronizedAfterSuiteAllProcsBody func()
 	SynchronizedAfterSuiteProc1Body    func()
 
-	ReportEachBody       func(types.SpecReport)
-	ReportAfterSuiteBody func(types.Report)
-
-	MarkedFocus   bool
-	MarkedPending bool
-	MarkedSerial  bool
-	MarkedOrdered bool
-	FlakeAttempts int
-	Labels        Labels
+	ReportEachBody  func(types.SpecReport)
+	ReportSuiteBody func(types.Report)
+
+	// IsAutogeneratedReport is set on the ReportAfterSuite node Ginkgo generates for --json-report, --junit-report, and --teamcity-report.
+	// Unlike user-provided reporting nodes, it runs during dry runs so that a dry run emits the full inventory of specs.
+	IsAutogeneratedReport bool
+
+	MarkedFocus             bool
+	MarkedPending           bool
+	MarkedSerial            bool
+	MarkedOrdered           bool
+	MarkedOncePerOrdered    bool
+	MarkedContinueOnFailure bool
+	FlakeAttempts           int
+	MustPassRepeatedly      int
+	SpecPriority            int
+	Labels                  Labels
+	ExclusiveKeys           ExclusiveKeys
+	NodeTimeout             time.Duration
+	SpecTimeout             time.Duration
+	GracePeriod             time.Duration
+	PollProgressAfter       time.Duration
+	HasContext              bool
 
 	NodeIDWhereCleanupWasGenerated uint
 }
@@ -55,16 +71,27 @@ type focusType bool
 type pendingType bool
 type serialType bool
 type orderedType bool
+type oncePerOrderedType bool
+type continueOnFailureType bool
 
 const Focus = focusType(true)
 const Pending = pendingType(true)
 const Serial = serialType(true)
 const Ordered = orderedType(true)
+const OncePerOrdered = oncePerOrderedType(true)
+const ContinueOnFailure = continueOnFailureType(true)
 
 type FlakeAttempts uint
+type MustPassRepeatedly uint
+type SpecPriority int
 type Offset uint
 type Done chan<- interface{} // Deprecated Done Channel for asynchronous testing
 type Labels []string
+type ExclusiveKeys []string
+type NodeTimeout time.Duration
+type SpecTimeout time.Duration
+type GracePeriod time.Duration
+type PollProgressAfter time.Duration
 
 func PartitionDecorations(args ...interface{}) ([]interface{}, []interface{}) {
 	decorations := []interface{}{}
@@ -95,10 +122,28 @@ func isDecoration(arg interface{}) bool {
 		return true
 	case t == reflect.TypeOf(Ordered):
 		return true
+	case t == reflect.TypeOf(OncePerOrdered):
+		return true
+	case t == reflect.TypeOf(ContinueOnFailure):
+		return true
 	case t == reflect.TypeOf(FlakeAttempts(0)):
 		return true
+	case t == reflect.TypeOf(MustPassRepeatedly(0)):
+		return true
+	case t == reflect.TypeOf(SpecPriority(0)):
+		return true
 	case t == reflect.TypeOf(Labels{}):
 		return true
+	case t == reflect.TypeOf(ExclusiveKeys{}):
+		return true
+	case t == reflect.TypeOf(NodeTimeout(0)):
+		return true
+	case t == reflect.TypeOf(SpecTimeout(0)):
+		return true
+	case t == reflect.TypeOf(GracePeriod(0)):
+		return true
+	case t == reflect.TypeOf(PollProgressAfter(0)):
+		return true
 	case t.Kind() == reflect.Slice && isSliceOfDecorations(arg):
 		return true
 	default:
@@ -180,11 +225,31 @@ func NewNode(deprecationTracker *types.DeprecationTracker, nodeType types.NodeTy
 			if !nodeType.Is(types.NodeTypeContainer) {
 				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "Ordered"))
 			}
+		case t == reflect.TypeOf(OncePerOrdered):
+			node.MarkedOncePerOrdered = bool(arg.(oncePerOrderedType))
+			if !nodeType.Is(types.NodeTypeBeforeEach | types.NodeTypeJustBeforeEach | types.NodeTypeAfterEach | types.NodeTypeJustAfterEach) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "OncePerOrdered"))
+			}
+		case t == reflect.TypeOf(ContinueOnFailure):
+			node.MarkedContinueOnFailure = bool(arg.(continueOnFailureType))
+			if !nodeType.Is(types.NodeTypeContainer) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "ContinueOnFailure"))
+			}
 		case t == reflect.TypeOf(FlakeAttempts(0)):
 			node.FlakeAttempts = int(arg.(FlakeAttempts))
 			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
 				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "FlakeAttempts"))
 			}
+		case t == reflect.TypeOf(MustPassRepeatedly(0)):
+			node.MustPassRepeatedly = int(arg.(MustPassRepeatedly))
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "MustPassRepeatedly"))
+			}
+		case t == reflect.TypeOf(SpecPriority(0)):
+			node.SpecPriority = int(arg.(SpecPriority))
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "SpecPriority"))
+			}
 		case t == reflect.TypeOf(Labels{}):
 			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
 				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "Label"))
@@ -197,24 +262,63 @@ func NewNode(deprecationTracker *types.DeprecationTracker, nodeType types.NodeTy
 					appendError(err)
 				}
 			}
+		case t == reflect.TypeOf(ExclusiveKeys{}):
+			node.ExclusiveKeys = append(node.ExclusiveKeys, arg.(ExclusiveKeys)...)
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "Exclusive"))
+			}
+		case t == reflect.TypeOf(NodeTimeout(0)):
+			node.NodeTimeout = time.Duration(arg.(NodeTimeout))
+			if !nodeType.Is(types.NodeTypesForSetupAndSubject) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "NodeTimeout"))
+			}
+		case t == reflect.TypeOf(SpecTimeout(0)):
+			node.SpecTimeout = time.Duration(arg.(SpecTimeout))
+			if !nodeType.Is(types.NodeTypeIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "SpecTimeout"))
+			}
+		case t == reflect.TypeOf(GracePeriod(0)):
+			node.GracePeriod = time.Duration(arg.(GracePeriod))
+			if !nodeType.Is(types.NodeTypesForSetupAndSubject) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "GracePeriod"))
+			}
+		case t == reflect.TypeOf(PollProgressAfter(0)):
+			node.PollProgressAfter = time.Duration(arg.(PollProgressAfter))
+			if !nodeType.Is(types.NodeTypesForSetupAndSubject) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "PollProgressAfter"))
+			}
 		case t.Kind() == reflect.Func:
 			if node.Body != nil {
 				appendError(types.GinkgoErrors.MultipleBodyFunctions(node.CodeLocation, nodeType))
 				trackedFunctionError = true
 				break
 			}
-			isValid := (t.NumOut() == 0) && (t.NumIn() <= 1) && (t.NumIn() == 0 || t.In(0) == reflect.TypeOf(make(Done)))
+			isValid := (t.NumOut() == 0) && (t.NumIn() <= 1) && (t.NumIn() == 0 || t.In(0) == reflect.TypeOf(make(Done)) || isContextType(t.In(0)))
 			if !isValid {
 				appendError(types.GinkgoErrors.InvalidBodyType(t, node.CodeLocation, nodeType))
 				trackedFunctionError = true
 				break
 			}
 			if t.NumIn() == 0 {
-				node.Body = arg.(func())
+				body := arg.(func())
+				node.Body = func(SpecContext) { body() }
+			} else if isContextType(t.In(0)) {
+				if nodeType.Is(types.NodeTypeContainer) {
+					appendError(types.GinkgoErrors.InvalidBodyTypeForContainer(t, node.CodeLocation, nodeType))
+					trackedFunctionError = true
+					break
+				}
+				node.HasContext = true
+				if t.In(0) == specContextType {
+					node.Body = arg.(func(SpecContext))
+				} else {
+					body := arg.(func(context.Context))
+					node.Body = func(c SpecContext) { body(c) }
+				}
 			} else {
 				deprecationTracker.TrackDeprecation(types.Deprecations.Async(), node.CodeLocation)
 				deprecatedAsyncBody := arg.(func(Done))
-				node.Body = func() { deprecatedAsyncBody(make(Done)) }
+				node.Body = func(SpecContext) { deprecatedAsyncBody(make(Done)) }
 			}
 		default:
 			remainingArgs = append(remainingArgs, arg)
@@ -226,9 +330,29 @@ func NewNode(deprecationTracker *types.DeprecationTracker, nodeType types.NodeTy
 		appendError(types.GinkgoErrors.InvalidDeclarationOfFocusedAndPending(node.CodeLocation, nodeType))
 	}
 
+	if node.MarkedContinueOnFailure && !node.MarkedOrdered {
+		appendError(types.GinkgoErrors.InvalidContinueOnFailureDecoration(node.CodeLocation))
+	}
+
+	if node.FlakeAttempts > 0 && node.MustPassRepeatedly > 0 {
+		appendError(types.GinkgoErrors.InvalidDeclarationOfFlakeAttemptsAndMustPassRepeatedly(node.CodeLocation, nodeType))
+	}
+
 	if node.Body == nil && !node.MarkedPending && !trackedFunctionError {
 		appendError(types.GinkgoErrors.MissingBodyFunction(node.CodeLocation, nodeType))
 	}
+
+	if !node.HasContext && node.Body != nil {
+		if node.NodeTimeout > 0 {
+			appendError(types.GinkgoErrors.InvalidTimeoutForNonContextNode(node.CodeLocation, nodeType, "NodeTimeout"))
+		}
+		if node.SpecTimeout > 0 {
+			appendError(types.GinkgoErrors.InvalidTimeoutForNonContextNode(node.CodeLocation, nodeType, "SpecTimeout"))
+		}
+		if node.GracePeriod > 0 {
+			appendError(types.GinkgoErrors.InvalidTimeoutForNonContextNode(node.CodeLocation, nodeType, "GracePeriod"))
+		}
+	}
 	for _, arg := range remainingArgs {
 		appendError(types.GinkgoErrors.UnknownDecorator(node.CodeLocation, nodeType, arg))
 	}
@@ -280,16 +404,32 @@ func NewReportAfterEachNode(body func(types.SpecReport), codeLocation types.Code
 	}, nil
 }
 
+func NewReportBeforeSuiteNode(body func(types.Report), codeLocation types.CodeLocation) (Node, []error) {
+	return Node{
+		ID:              UniqueNodeID(),
+		NodeType:        types.NodeTypeReportBeforeSuite,
+		ReportSuiteBody: body,
+		CodeLocation:    codeLocation,
+	}, nil
+}
+
 func NewReportAfterSuiteNode(text string, body func(types.Report), codeLocation types.CodeLocation) (Node, []error) {
 	return Node{
-		ID:                   UniqueNodeID(),
-		Text:                 text,
-		NodeType:             types.NodeTypeReportAfterSuite,
-		ReportAfterSuiteBody: body,
-		CodeLocation:         codeLocation,
+		ID:              UniqueNodeID(),
+		Text:            text,
+		NodeType:        types.NodeTypeReportAfterSuite,
+		ReportSuiteBody: body,
+		CodeLocation:    codeLocation,
 	}, nil
 }
 
+var specContextType = reflect.TypeOf((*SpecContext)(nil)).Elem()
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+func isContextType(t reflect.Type) bool {
+	return t == specContextType || t == contextType
+}
+
 func NewCleanupNode(fail func(string, types.CodeLocation), args ...interface{}) (Node, []error) {
 	baseOffset := 2
 	node := Node{
@@ -305,6 +445,10 @@ func NewCleanupNode(fail func(string, types.CodeLocation), args ...interface{})
 			node.CodeLocation = types.NewCodeLocation(baseOffset + int(arg.(Offset)))
 		case t == reflect.TypeOf(types.CodeLocation{}):
 			node.CodeLocation = arg.(types.CodeLocation)
+		case t == reflect.TypeOf(NodeTimeout(0)):
+			node.NodeTimeout = time.Duration(arg.(NodeTimeout))
+		case t == reflect.TypeOf(GracePeriod(0)):
+			node.GracePeriod = time.Duration(arg.(GracePeriod))
 		default:
 			remainingArgs = append(remainingArgs, arg)
 		}
@@ -321,9 +465,23 @@ func NewCleanupNode(fail func(string, types.CodeLocation), args ...interface{})
 	for _, arg := range remainingArgs[1:] {
 		callArgs = append(callArgs, reflect.ValueOf(arg))
 	}
+	//if the callback expects a context and one wasn't provided, we pass in the node's SpecContext
+	callbackType := callback.Type()
+	hasContext := callbackType.NumIn() > 0 && isContextType(callbackType.In(0)) && !callbackType.IsVariadic() && len(callArgs) == callbackType.NumIn()-1
+	node.HasContext = hasContext
+	if node.NodeTimeout > 0 && !node.HasContext {
+		return Node{}, []error{types.GinkgoErrors.InvalidTimeoutForNonContextNode(node.CodeLocation, types.NodeTypeCleanupInvalid, "NodeTimeout")}
+	}
+	if node.GracePeriod > 0 && !node.HasContext {
+		return Node{}, []error{types.GinkgoErrors.InvalidTimeoutForNonContextNode(node.CodeLocation, types.NodeTypeCleanupInvalid, "GracePeriod")}
+	}
 	cl := node.CodeLocation
-	node.Body = func() {
-		out := callback.Call(callArgs)
+	node.Body = func(c SpecContext) {
+		args := callArgs
+		if hasContext {
+			args = append([]reflect.Value{reflect.ValueOf(c)}, callArgs...)
+		}
+		out := callback.Call(args)
 		if len(out) == 1 && !out[0].IsNil() {
 			fail(fmt.Sprintf("DeferCleanup callback returned error: %v", out[0]), cl)
 		}
@@ -607,7 +765,7 @@ func unrollInterfaceSlice(args interface{}) []interface{} {
 	out := []interface{}{}
 	for i := 0; i < v.Len(); i++ {
 		el := reflect.ValueOf(v.Index(i).Interface())
-		if el.Kind() == reflect.Slice && el.Type() != reflect.TypeOf(Labels{}) {
+		if el.Kind() == reflect.Slice && el.Type() != reflect.TypeOf(Labels{}) && el.Type() != reflect.TypeOf(ExclusiveKeys{}) {
 			out = append(out, unrollInterfaceSlice(el.Interface())...)
 		} else {
 			out = append(out, v.Index(i).Interface())
@@ -1,6 +1,7 @@
 package internal_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"reflect"
@@ -95,7 +96,7 @@ var _ = Describe("Constructing nodes", func() {
 			Ω(node.ID).Should(BeNumerically(">", 0))
 			Ω(node.NodeType).Should(Equal(ntIt))
 			Ω(node.Text).Should(Equal("text"))
-			node.Body()
+			node.Body(nil)
 			Ω(didRun).Should(BeTrue())
 			Ω(node.CodeLocation).Should(Equal(cl))
 			Ω(node.MarkedFocus).Should(BeTrue())
@@ -140,7 +141,7 @@ var _ = Describe("Constructing nodes", func() {
 	Describe("ignoring deprecated timeouts", func() {
 		It("ignores any float64s", func() {
 			node, errors := internal.NewNode(dt, ntIt, "text", body, 3.141, 2.71)
-			node.Body()
+			node.Body(nil)
 			Ω(didRun).Should(BeTrue())
 			ExpectAllWell(errors)
 		})
@@ -242,6 +243,94 @@ var _ = Describe("Constructing nodes", func() {
 		})
 	})
 
+	Describe("The OncePerOrdered decoration", func() {
+		It("applies to setup nodes that run around each spec", func() {
+			for _, nt := range []types.NodeType{ntBef, types.NodeTypeJustBeforeEach, ntAf, types.NodeTypeJustAfterEach} {
+				node, errors := internal.NewNode(dt, nt, "", body, OncePerOrdered)
+				Ω(node.MarkedOncePerOrdered).Should(BeTrue())
+				ExpectAllWell(errors)
+			}
+		})
+
+		It("does not apply to other node types", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, cl, OncePerOrdered)
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntIt, "OncePerOrdered")))
+
+			node, errors = internal.NewNode(dt, ntCon, "text", body, cl, OncePerOrdered)
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntCon, "OncePerOrdered")))
+			Ω(dt.DidTrackDeprecations()).Should(BeFalse())
+		})
+	})
+
+	Describe("The ContinueOnFailure decoration", func() {
+		It("applies to Ordered containers", func() {
+			node, errors := internal.NewNode(dt, ntCon, "text", body, Ordered, ContinueOnFailure)
+			Ω(node.MarkedContinueOnFailure).Should(BeTrue())
+			ExpectAllWell(errors)
+		})
+
+		It("errors when applied to a container that is not Ordered", func() {
+			node, errors := internal.NewNode(dt, ntCon, "text", body, cl, ContinueOnFailure)
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidContinueOnFailureDecoration(cl)))
+			Ω(dt.DidTrackDeprecations()).Should(BeFalse())
+		})
+
+		It("does not apply to other node types", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, cl, ContinueOnFailure)
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ContainElement(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntIt, "ContinueOnFailure")))
+		})
+	})
+
+	Describe("The SpecPriority decoration", func() {
+		It("is zero by default", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body)
+			Ω(node.SpecPriority).Should(Equal(0))
+			ExpectAllWell(errors)
+		})
+		It("sets the SpecPriority field on containers and subject nodes", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, SpecPriority(3))
+			Ω(node.SpecPriority).Should(Equal(3))
+			ExpectAllWell(errors)
+
+			node, errors = internal.NewNode(dt, ntCon, "text", body, SpecPriority(-2))
+			Ω(node.SpecPriority).Should(Equal(-2))
+			ExpectAllWell(errors)
+		})
+		It("cannot be applied to non-container/it nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, cl, SpecPriority(2))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "SpecPriority")))
+			Ω(dt.DidTrackDeprecations()).Should(BeFalse())
+		})
+	})
+
+	Describe("The Exclusive decoration", func() {
+		It("has no keys by default", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body)
+			Ω(node.ExclusiveKeys).Should(BeEmpty())
+			ExpectAllWell(errors)
+		})
+		It("sets the ExclusiveKeys field on containers and subject nodes", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, Exclusive("db"), Exclusive("port", "queue"))
+			Ω(node.ExclusiveKeys).Should(Equal(ExclusiveKeys{"db", "port", "queue"}))
+			ExpectAllWell(errors)
+
+			node, errors = internal.NewNode(dt, ntCon, "text", body, Exclusive("db"))
+			Ω(node.ExclusiveKeys).Should(Equal(ExclusiveKeys{"db"}))
+			ExpectAllWell(errors)
+		})
+		It("cannot be applied to non-container/it nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, cl, Exclusive("db"))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "Exclusive")))
+			Ω(dt.DidTrackDeprecations()).Should(BeFalse())
+		})
+	})
+
 	Describe("The FlakeAttempts decoration", func() {
 		It("is zero by default", func() {
 			node, errors := internal.NewNode(dt, ntIt, "text", body)
@@ -267,6 +356,144 @@ var _ = Describe("Constructing nodes", func() {
 		})
 	})
 
+	Describe("The MustPassRepeatedly decoration", func() {
+		It("is zero by default", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body)
+			Ω(node).ShouldNot(BeZero())
+			Ω(node.MustPassRepeatedly).Should(Equal(0))
+			ExpectAllWell(errors)
+		})
+		It("sets the MustPassRepeatedly field", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, MustPassRepeatedly(3))
+			Ω(node.MustPassRepeatedly).Should(Equal(3))
+			ExpectAllWell(errors)
+		})
+		It("can be applied to containers", func() {
+			node, errors := internal.NewNode(dt, ntCon, "text", body, MustPassRepeatedly(3))
+			Ω(node.MustPassRepeatedly).Should(Equal(3))
+			ExpectAllWell(errors)
+		})
+		It("cannot be applied to non-container/it nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, cl, MustPassRepeatedly(3))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "MustPassRepeatedly")))
+			Ω(dt.DidTrackDeprecations()).Should(BeFalse())
+		})
+		It("cannot be combined with FlakeAttempts", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, cl, FlakeAttempts(2), MustPassRepeatedly(3))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDeclarationOfFlakeAttemptsAndMustPassRepeatedly(cl, ntIt)))
+			Ω(dt.DidTrackDeprecations()).Should(BeFalse())
+		})
+	})
+
+	Describe("The NodeTimeout and SpecTimeout decorations", func() {
+		var contextBody func(SpecContext)
+		BeforeEach(func() {
+			contextBody = func(SpecContext) { didRun = true }
+		})
+
+		It("are zero by default", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", contextBody)
+			Ω(node.NodeTimeout).Should(BeZero())
+			Ω(node.SpecTimeout).Should(BeZero())
+			ExpectAllWell(errors)
+		})
+
+		It("sets the NodeTimeout and SpecTimeout fields", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", contextBody, NodeTimeout(time.Second), SpecTimeout(time.Minute))
+			Ω(node.NodeTimeout).Should(Equal(time.Second))
+			Ω(node.SpecTimeout).Should(Equal(time.Minute))
+			ExpectAllWell(errors)
+		})
+
+		It("allows NodeTimeout on setup nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", contextBody, NodeTimeout(time.Second))
+			Ω(node.NodeTimeout).Should(Equal(time.Second))
+			ExpectAllWell(errors)
+		})
+
+		It("does not allow NodeTimeout on containers", func() {
+			node, errors := internal.NewNode(dt, ntCon, "text", body, cl, NodeTimeout(time.Second))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ContainElement(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntCon, "NodeTimeout")))
+		})
+
+		It("does not allow SpecTimeout on non-It nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", contextBody, cl, SpecTimeout(time.Second))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "SpecTimeout")))
+		})
+
+		It("errors if the node does not accept a context", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, cl, NodeTimeout(time.Second), SpecTimeout(time.Minute))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(
+				types.GinkgoErrors.InvalidTimeoutForNonContextNode(cl, ntIt, "NodeTimeout"),
+				types.GinkgoErrors.InvalidTimeoutForNonContextNode(cl, ntIt, "SpecTimeout"),
+			))
+		})
+	})
+
+	Describe("The GracePeriod decoration", func() {
+		var contextBody func(SpecContext)
+		BeforeEach(func() {
+			contextBody = func(SpecContext) { didRun = true }
+		})
+
+		It("is zero by default", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", contextBody)
+			Ω(node.GracePeriod).Should(BeZero())
+			ExpectAllWell(errors)
+		})
+
+		It("sets the GracePeriod field on subject and setup nodes", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", contextBody, GracePeriod(time.Second))
+			Ω(node.GracePeriod).Should(Equal(time.Second))
+			ExpectAllWell(errors)
+
+			node, errors = internal.NewNode(dt, ntAf, "", contextBody, GracePeriod(time.Minute))
+			Ω(node.GracePeriod).Should(Equal(time.Minute))
+			ExpectAllWell(errors)
+		})
+
+		It("does not allow GracePeriod on containers", func() {
+			node, errors := internal.NewNode(dt, ntCon, "text", body, cl, GracePeriod(time.Second))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ContainElement(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntCon, "GracePeriod")))
+		})
+
+		It("errors if the node does not accept a context", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, cl, GracePeriod(time.Second))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidTimeoutForNonContextNode(cl, ntIt, "GracePeriod")))
+		})
+	})
+
+	Describe("The PollProgressAfter decoration", func() {
+		It("is zero by default", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body)
+			Ω(node.PollProgressAfter).Should(BeZero())
+			ExpectAllWell(errors)
+		})
+
+		It("sets the PollProgressAfter field on subject and setup nodes, and does not require a context", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, PollProgressAfter(time.Second))
+			Ω(node.PollProgressAfter).Should(Equal(time.Second))
+			ExpectAllWell(errors)
+
+			node, errors = internal.NewNode(dt, ntBef, "", body, PollProgressAfter(time.Minute))
+			Ω(node.PollProgressAfter).Should(Equal(time.Minute))
+			ExpectAllWell(errors)
+		})
+
+		It("does not allow PollProgressAfter on containers", func() {
+			node, errors := internal.NewNode(dt, ntCon, "text", body, cl, PollProgressAfter(time.Second))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntCon, "PollProgressAfter")))
+		})
+	})
+
 	Describe("The Label decoration", func() {
 		It("has no labels by default", func() {
 			node, errors := internal.NewNode(dt, ntIt, "text", body)
@@ -311,7 +538,7 @@ var _ = Describe("Constructing nodes", func() {
 	Describe("passing in functions", func() {
 		It("works when a single function is passed in", func() {
 			node, errors := internal.NewNode(dt, ntIt, "text", body, cl)
-			node.Body()
+			node.Body(nil)
 			Ω(didRun).Should(BeTrue())
 			ExpectAllWell(errors)
 		})
@@ -322,12 +549,49 @@ var _ = Describe("Constructing nodes", func() {
 				Ω(done).ShouldNot(BeNil())
 				close(done)
 			}, cl)
-			node.Body()
+			node.Body(nil)
 			Ω(didRun).Should(BeTrue())
 			Ω(errors).Should(BeEmpty())
 			Ω(dt.DeprecationsReport()).Should(ContainSubstring(types.Deprecations.Async().Message))
 		})
 
+		It("allows functions that take a SpecContext", func() {
+			var receivedContext SpecContext
+			node, errors := internal.NewNode(dt, ntIt, "text", func(c SpecContext) {
+				receivedContext = c
+			}, cl)
+			sc := internal.NewSpecContext(nil, time.Time{})
+			node.Body(sc)
+			Ω(receivedContext).Should(Equal(sc))
+			Ω(node.HasContext).Should(BeTrue())
+			ExpectAllWell(errors)
+		})
+
+		It("allows functions that take a context.Context", func() {
+			var receivedContext context.Context
+			node, errors := internal.NewNode(dt, ntBef, "", func(c context.Context) {
+				receivedContext = c
+			}, cl)
+			sc := internal.NewSpecContext(nil, time.Time{})
+			node.Body(sc)
+			Ω(receivedContext).Should(Equal(sc))
+			Ω(node.HasContext).Should(BeTrue())
+			ExpectAllWell(errors)
+		})
+
+		It("does not mark functions that take no arguments as having a context", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, cl)
+			Ω(node.HasContext).Should(BeFalse())
+			ExpectAllWell(errors)
+		})
+
+		It("errors if a container is passed a function that takes a context", func() {
+			f := func(SpecContext) {}
+			node, errors := internal.NewNode(dt, ntCon, "text", f, cl)
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidBodyTypeForContainer(reflect.TypeOf(f), cl, ntCon)))
+		})
+
 		It("errors if more than one function is provided", func() {
 			node, errors := internal.NewNode(dt, ntIt, "text", body, body, cl)
 			Ω(node).Should(BeZero())
@@ -391,7 +655,7 @@ var _ = Describe("Constructing nodes", func() {
 			Ω(node.FlakeAttempts).Should(Equal(2))
 			Ω(node.MarkedFocus).Should(BeTrue())
 			Ω(node.Labels).Should(Equal(Labels{"A", "B", "C", "D"}))
-			node.Body()
+			node.Body(nil)
 			Ω(didRun).Should(BeTrue())
 			ExpectAllWell(errors)
 		})
@@ -479,6 +743,23 @@ var _ = Describe("Node", func() {
 			})
 		})
 
+		Describe("NewReportBeforeSuiteNode", func() {
+			It("returns a correctly configured node", func() {
+				var didRun bool
+				body := func(types.Report) { didRun = true }
+				node, errors := internal.NewReportBeforeSuiteNode(body, cl)
+				Ω(errors).Should(BeEmpty())
+				Ω(node.ID).Should(BeNumerically(">", 0))
+				Ω(node.NodeType).Should(Equal(types.NodeTypeReportBeforeSuite))
+
+				node.ReportSuiteBody(types.Report{})
+				Ω(didRun).Should(BeTrue())
+
+				Ω(node.CodeLocation).Should(Equal(cl))
+				Ω(node.NestingLevel).Should(Equal(0))
+			})
+		})
+
 		Describe("NewReportAfterSuiteNode", func() {
 			It("returns a correctly configured node", func() {
 				var didRun bool
@@ -489,7 +770,7 @@ var _ = Describe("Node", func() {
 				Ω(node.ID).Should(BeNumerically(">", 0))
 				Ω(node.NodeType).Should(Equal(types.NodeTypeReportAfterSuite))
 
-				node.ReportAfterSuiteBody(types.Report{})
+				node.ReportSuiteBody(types.Report{})
 				Ω(didRun).Should(BeTrue())
 
 				Ω(node.CodeLocation).Should(Equal(cl))
@@ -543,7 +824,7 @@ var _ = Describe("Node", func() {
 					Ω(node.NodeType).Should(Equal(types.NodeTypeCleanupInvalid))
 					Ω(errs).Should(BeEmpty())
 
-					node.Body()
+					node.Body(nil)
 					Ω(didRun).Should(BeTrue())
 					Ω(capturedFailure).Should(BeZero())
 					Ω(capturedCL).Should(BeZero())
@@ -561,7 +842,7 @@ var _ = Describe("Node", func() {
 					Ω(node.NodeType).Should(Equal(types.NodeTypeCleanupInvalid))
 					Ω(errs).Should(BeEmpty())
 
-					node.Body()
+					node.Body(nil)
 					Ω(didRun).Should(BeTrue())
 					Ω(capturedFailure).Should(BeZero())
 					Ω(capturedCL).Should(BeZero())
@@ -579,7 +860,7 @@ var _ = Describe("Node", func() {
 					Ω(node.NodeType).Should(Equal(types.NodeTypeCleanupInvalid))
 					Ω(errs).Should(BeEmpty())
 
-					node.Body()
+					node.Body(nil)
 					Ω(didRun).Should(BeTrue())
 					Ω(capturedFailure).Should(Equal("DeferCleanup callback returned error: welp"))
 					Ω(capturedCL).Should(Equal(cl))
@@ -600,7 +881,7 @@ var _ = Describe("Node", func() {
 					Ω(node.NodeType).Should(Equal(types.NodeTypeCleanupInvalid))
 					Ω(errs).Should(BeEmpty())
 
-					node.Body()
+					node.Body(nil)
 					Ω(receivedA).Should(Equal("A"))
 					Ω(receivedB).Should(Equal(2))
 					Ω(receivedC).Should(Equal("C"))
@@ -609,6 +890,61 @@ var _ = Describe("Node", func() {
 				})
 			})
 
+			Context("when passed a function that takes a context and no context is provided", func() {
+				It("passes in the node's context", func() {
+					var receivedContext context.Context
+					var receivedA string
+					node, errs := internal.NewCleanupNode(failFunc, cl, func(c context.Context, a string) {
+						receivedContext, receivedA = c, a
+					}, "A", NodeTimeout(time.Second))
+					Ω(errs).Should(BeEmpty())
+					Ω(node.HasContext).Should(BeTrue())
+					Ω(node.NodeTimeout).Should(Equal(time.Second))
+
+					sc := internal.NewSpecContext(nil, time.Time{})
+					node.Body(sc)
+					Ω(receivedContext).Should(Equal(sc))
+					Ω(receivedA).Should(Equal("A"))
+				})
+			})
+
+			Context("when passed a function that takes a context and a context is provided", func() {
+				It("passes in the provided context", func() {
+					var receivedContext context.Context
+					providedContext := context.WithValue(context.Background(), "key", "value")
+					node, errs := internal.NewCleanupNode(failFunc, cl, func(c context.Context) {
+						receivedContext = c
+					}, providedContext)
+					Ω(errs).Should(BeEmpty())
+					Ω(node.HasContext).Should(BeFalse())
+
+					node.Body(internal.NewSpecContext(nil, time.Time{}))
+					Ω(receivedContext).Should(Equal(providedContext))
+				})
+			})
+
+			Context("when passed a NodeTimeout with a function that does not take a context", func() {
+				It("errors", func() {
+					node, errs := internal.NewCleanupNode(failFunc, cl, func() {}, NodeTimeout(time.Second))
+					Ω(node.IsZero()).Should(BeTrue())
+					Ω(errs).Should(ConsistOf(types.GinkgoErrors.InvalidTimeoutForNonContextNode(cl, types.NodeTypeCleanupInvalid, "NodeTimeout")))
+				})
+			})
+
+			Context("when passed a GracePeriod", func() {
+				It("records it if the function takes a context", func() {
+					node, errs := internal.NewCleanupNode(failFunc, cl, func(c context.Context) {}, GracePeriod(time.Second))
+					Ω(errs).Should(BeEmpty())
+					Ω(node.GracePeriod).Should(Equal(time.Second))
+				})
+
+				It("errors if the function does not take a context", func() {
+					node, errs := internal.NewCleanupNode(failFunc, cl, func() {}, GracePeriod(time.Second))
+					Ω(node.IsZero()).Should(BeTrue())
+					Ω(errs).Should(ConsistOf(types.GinkgoErrors.InvalidTimeoutForNonContextNode(cl, types.NodeTypeCleanupInvalid, "GracePeriod")))
+				})
+			})
+
 			Context("controlling the cleanup's code location", func() {
 				It("computes its own when one is not provided", func() {
 					node, errs := func() (internal.Node, []error) {
@@ -622,7 +958,7 @@ var _ = Describe("Node", func() {
 					Ω(node.NodeType).Should(Equal(types.NodeTypeCleanupInvalid))
 					Ω(errs).Should(BeEmpty())
 
-					node.Body()
+					node.Body(nil)
 					Ω(capturedFailure).Should(Equal("DeferCleanup callback returned error: welp"))
 					Ω(capturedCL).Should(Equal(localCL))
 				})
@@ -641,7 +977,7 @@ var _ = Describe("Node", func() {
 					Ω(node.NodeType).Should(Equal(types.NodeTypeCleanupInvalid))
 					Ω(errs).Should(BeEmpty())
 
-					node.Body()
+					node.Body(nil)
 					Ω(capturedFailure).Should(Equal("DeferCleanup callback returned error: welp"))
 					Ω(capturedCL).Should(Equal(localCL))
 
@@ -655,7 +991,7 @@ var _ = Describe("Node", func() {
 					Ω(node.NodeType).Should(Equal(types.NodeTypeCleanupInvalid))
 					Ω(errs).Should(BeEmpty())
 
-					node.Body()
+					node.Body(nil)
 					Ω(capturedFailure).Should(Equal("DeferCleanup callback returned error: welp"))
 					Ω(capturedCL).Should(Equal(cl))
 				})
@@ -1,9 +1,14 @@
 package internal
 
 import (
+	"encoding/binary"
+	"hash/fnv"
 	"math/rand"
+	"path/filepath"
 	"sort"
+	"strings"
 
+	"github.com/onsi-experimental/ginkgo/v2/internal/parallel_support"
 	"github.com/onsi-experimental/ginkgo/v2/types"
 )
 
@@ -22,11 +27,17 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 
 		In addition, spec containers can be marked as Ordered.  Specs within an Ordered container are never shuffled.
 
-		Finally, specs and spec containers can be marked as Serial.  When running in parallel, serial specs run on Process #1 _after_ all other processes have finished.
+		Specs and spec containers can be marked as Serial.  When running in parallel, serial specs run on Process #1 _after_ all other processes have finished.
+
+		By default groups are sorted by code location before they are shuffled, so edits that shift line numbers change the order produced by a given seed.
+		Developers can set --order-by=identity to instead order groups by a hash of the seed and each group's texts and file name.  The relative order of any two
+		groups then depends only on the seed and their identities and survives edits elsewhere in the suite - including the addition and removal of other specs.
+
+		Finally, specs and spec containers can be given a SpecPriority.  Higher priority groups are moved to the front of the randomized order so
+		that long-running specs are dispatched early when running in parallel.  Groups with the same priority retain their randomized order.
 	*/
 
-	// Seed a new random source based on thee configured random seed.
-	r := rand.New(rand.NewSource(suiteConfig.RandomSeed))
+	orderByIdentity := strings.ToLower(suiteConfig.OrderBy) == "identity"
 
 	// Decide how to group specs for shuffling.  By default we shuffle top-level containers,
 	// but setting --randomize-all-specs causes us to shuffle all specs (excpect for Ordered specs)
@@ -56,12 +67,16 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 		permutableGroups[groupingNode.ID] = append(permutableGroups[groupingNode.ID], idx)
 		// and, while we're at it, extract the sort key for this group if we haven't already.
 		if groupSortKeys[groupingNode.ID] == "" {
-			groupSortKeys[groupingNode.ID] = groupingNode.CodeLocation.String()
+			if orderByIdentity {
+				groupSortKeys[groupingNode.ID] = identityOfGroup(spec, groupingNode)
+			} else {
+				groupSortKeys[groupingNode.ID] = groupingNode.CodeLocation.String()
+			}
 			groupIDs = append(groupIDs, groupingNode.ID)
 		}
 	}
 
-	// now sort the groups by the sort key.  We use the grouping node's code location and break ties using group ID
+	// now sort the groups by the sort key.  We use the grouping node's code location (or identity) and break ties using group ID
 	sort.SliceStable(groupIDs, func(i, j int) bool {
 		keyA := groupSortKeys[groupIDs[i]]
 		keyB := groupSortKeys[groupIDs[j]]
@@ -72,9 +87,29 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 		}
 	})
 
-	// now permute the sorted group IDs and build the ordered Groups
+	// now permute the sorted group IDs
+	var permutation []int
+	if orderByIdentity {
+		// when ordering by identity we sort by a hash of the seed and each group's identity.  Unlike a permutation drawn from a random source
+		// this does not depend on the number of groups, so adding or removing a group does not reshuffle the others.
+		permutation = make([]int, len(groupIDs))
+		groupHashes := make([]uint64, len(groupIDs))
+		for i := range groupIDs {
+			permutation[i] = i
+			groupHashes[i] = seededHash(suiteConfig.RandomSeed, groupSortKeys[groupIDs[i]])
+		}
+		// groupIDs is already sorted, so a stable sort breaks hash ties deterministically
+		sort.SliceStable(permutation, func(i, j int) bool {
+			return groupHashes[permutation[i]] < groupHashes[permutation[j]]
+		})
+	} else {
+		// Seed a new random source based on thee configured random seed.
+		r := rand.New(rand.NewSource(suiteConfig.RandomSeed))
+		permutation = r.Perm(len(groupIDs))
+	}
+
+	// and build the ordered Groups
 	orderedGroups := GroupedSpecIndices{}
-	permutation := r.Perm(len(groupIDs))
 	for _, j := range permutation {
 		if groupIsMarkedOrdered[groupIDs[j]] {
 			// If the group is marked ordered, we preserve the grouping to ensure ordered specs always run on the same Ginkgo process
@@ -87,14 +122,25 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 		}
 	}
 
+	// now move higher priority groups to the front.  This is a stable sort so groups with the same priority retain their randomized order.
+	// a group's priority is the highest priority of any of its specs.  Groups are keyed by their first spec index, which is unique.
+	groupPriorities := map[int]int{}
+	for _, specIndices := range orderedGroups {
+		groupPriorities[specIndices[0]] = priorityOfGroup(specs, specIndices)
+	}
+	sort.SliceStable(orderedGroups, func(i, j int) bool {
+		return groupPriorities[orderedGroups[i][0]] > groupPriorities[orderedGroups[j][0]]
+	})
+
 	// If we're running in series, we're done.
-	if suiteConfig.ParallelTotal == 1 {
+	if suiteConfig.ParallelTotal == 1 && suiteConfig.ComputedParallelGoroutines() == 1 {
 		return orderedGroups, GroupedSpecIndices{}
 	}
 
 	// We're running in parallel so we need to partition the ordered groups into a parallelizable set and a serialized set.
 	// The parallelizable groups will run across all Ginkgo processes...
 	// ...the serial groups will only run on Process #1 after all other processes have exited.
+	// (When running in parallel across goroutines the serial groups run after all the parallelizable groups have completed.)
 	parallelizableGroups, serialGroups := GroupedSpecIndices{}, GroupedSpecIndices{}
 	for _, specIndices := range orderedGroups {
 		if specs[specIndices[0]].Nodes.HasNodeMarkedSerial() {
@@ -106,3 +152,56 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 
 	return parallelizableGroups, serialGroups
 }
+
+// identityOfGroup identifies the group with the passed-in grouping node by the name of the file the grouping node is declared in and the texts of the
+// spec's containers and subject node up to and including the grouping node
+func identityOfGroup(spec Spec, groupingNode Node) string {
+	identity := []string{filepath.Base(groupingNode.CodeLocation.FileName)}
+	for _, node := range spec.Nodes.WithType(types.NodeTypesForContainerAndIt) {
+		identity = append(identity, node.Text)
+		if node.ID == groupingNode.ID {
+			break
+		}
+	}
+	return strings.Join(identity, "\x00")
+}
+
+func seededHash(seed int64, key string) uint64 {
+	hash := fnv.New64a()
+	seedBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(seedBytes, uint64(seed))
+	hash.Write(seedBytes)
+	hash.Write([]byte(key))
+	return hash.Sum64()
+}
+
+func priorityOfGroup(specs Specs, specIndices SpecIndices) int {
+	priority := specs[specIndices[0]].SpecPriority()
+	for _, idx := range specIndices {
+		priority = max(priority, specs[idx].SpecPriority())
+	}
+	return priority
+}
+
+// specGroupsForScheduling returns the priority of each group along with the IDs and Exclusive keys of its specs that will run.  The parallel support
+// server uses these to hand out the slowest groups first and to ensure that groups holding the same Exclusive key never run at the same time.
+func specGroupsForScheduling(specs Specs, groupedSpecIndices GroupedSpecIndices) []parallel_support.SpecGroup {
+	out := make([]parallel_support.SpecGroup, len(groupedSpecIndices))
+	for i, group := range groupedSpecIndices {
+		out[i] = parallel_support.SpecGroup{SpecIDs: []string{}, Priority: priorityOfGroup(specs, group)}
+		keysSeen := map[string]bool{}
+		for _, idx := range group {
+			if specs[idx].Skip || specs[idx].Nodes.HasNodeMarkedPending() {
+				continue
+			}
+			out[i].SpecIDs = append(out[i].SpecIDs, specs[idx].ID)
+			for _, key := range specs[idx].ExclusiveKeys() {
+				if !keysSeen[key] {
+					keysSeen[key] = true
+					out[i].ExclusiveKeys = append(out[i].ExclusiveKeys, key)
+				}
+			}
+		}
+	}
+	return out
+}
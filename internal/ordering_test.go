@@ -157,6 +157,88 @@ var _ = Describe("OrderSpecs", func() {
 		})
 	})
 
+	Context("when ordering by identity", func() {
+		var specsAt func(lineOffset int, extraSpecs ...Spec) Specs
+		BeforeEach(func() {
+			conf.OrderBy = "identity"
+			specsAt = func(lineOffset int, extraSpecs ...Spec) Specs {
+				con1 := N(ntCon, "con1", CL("/path/to/file_A", 10+lineOffset))
+				con2 := N(ntCon, "con2", Ordered, CL("/path/to/file_B", 10+lineOffset))
+				out := Specs{
+					S(N("A", ntIt, CL("/path/to/file_A", 1+lineOffset))),
+					S(N("B", ntIt, CL("/path/to/file_A", 2+lineOffset))),
+					S(con1, N("C", ntIt, CL("/path/to/file_A", 11+lineOffset))),
+					S(con1, N("D", ntIt, CL("/path/to/file_A", 12+lineOffset))),
+					S(N("E", ntIt, CL("/path/to/file_B", 1+lineOffset))),
+					S(con2, N("F", ntIt, CL("/path/to/file_B", 11+lineOffset))),
+					S(con2, N("G", ntIt, CL("/path/to/file_B", 12+lineOffset))),
+				}
+				return append(out, extraSpecs...)
+			}
+		})
+
+		It("shuffles the specs and generates the same order for the same seed", func() {
+			specs = specsAt(0)
+			conf.RandomSeed = 1
+			groupedSpecIndices1, _ := internal.OrderSpecs(specs, conf)
+			Ω(getTexts(specs, groupedSpecIndices1)).Should(ConsistOf("A", "B", "con1 C", "con1 D", "E", "con2 F", "con2 G"))
+			Ω(getTexts(specs, groupedSpecIndices1).Join()).Should(ContainSubstring("con2 Fcon2 G"))
+			groupedSpecIndicesAgain, _ := internal.OrderSpecs(specs, conf)
+			Ω(getTexts(specs, groupedSpecIndicesAgain)).Should(Equal(getTexts(specs, groupedSpecIndices1)))
+
+			conf.RandomSeed = 2
+			groupedSpecIndices2, _ := internal.OrderSpecs(specs, conf)
+			Ω(getTexts(specs, groupedSpecIndices1)).ShouldNot(Equal(getTexts(specs, groupedSpecIndices2)))
+		})
+
+		It("generates the same order when line numbers and file paths change", func() {
+			for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+				specs = specsAt(0)
+				groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+				shiftedSpecs := specsAt(17)
+				shiftedGroupedSpecIndices, _ := internal.OrderSpecs(shiftedSpecs, conf)
+				Ω(getTexts(shiftedSpecs, shiftedGroupedSpecIndices)).Should(Equal(getTexts(specs, groupedSpecIndices)))
+			}
+		})
+
+		It("preserves the relative order of existing specs when specs are added", func() {
+			for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+				specs = specsAt(0)
+				groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+				specsWithAdditions := specsAt(3, S(N("H", ntIt, CL("/path/to/file_A", 30))), S(N("I", ntIt, CL("/path/to/file_C", 1))))
+				groupedSpecIndicesWithAdditions, _ := internal.OrderSpecs(specsWithAdditions, conf)
+
+				textsWithoutAdditions := SpecTexts{}
+				for _, text := range getTexts(specsWithAdditions, groupedSpecIndicesWithAdditions) {
+					if text != "H" && text != "I" {
+						textsWithoutAdditions = append(textsWithoutAdditions, text)
+					}
+				}
+				Ω(textsWithoutAdditions).Should(Equal(getTexts(specs, groupedSpecIndices)))
+			}
+		})
+
+		It("only shuffles top-level containers and specs unless configured to randomize all specs", func() {
+			specs = specsAt(0)
+			for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+				groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+				Ω(getTexts(specs, groupedSpecIndices).Join()).Should(ContainSubstring("con1 Ccon1 D"))
+			}
+
+			conf.RandomizeAllSpecs = true
+			hasCD := true
+			for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+				groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+				Ω(getTexts(specs, groupedSpecIndices).Join()).Should(ContainSubstring("con2 Fcon2 G"))
+				hasCD, _ = ContainSubstring("con1 Ccon1 D").Match(getTexts(specs, groupedSpecIndices).Join())
+				if !hasCD {
+					break
+				}
+			}
+			Ω(hasCD).Should(BeFalse())
+		})
+	})
+
 	Context("when there are ordered specs", func() {
 		BeforeEach(func() {
 			con1 := N(ntCon, Ordered)
@@ -185,6 +267,55 @@ var _ = Describe("OrderSpecs", func() {
 		})
 	})
 
+	Context("when specs have a SpecPriority", func() {
+		BeforeEach(func() {
+			con1 := N(ntCon, Ordered)
+			con2 := N(ntCon, SpecPriority(5))
+			specs = Specs{
+				S(N("A", ntIt)),
+				S(N("B", ntIt, SpecPriority(10))),
+				S(con1, N("C", ntIt)),
+				S(con1, N("D", ntIt, SpecPriority(7))),
+				S(con1, N(ntCon), N("E", ntIt)),
+				S(N("F", ntIt, SpecPriority(-1))),
+				S(con2, N("G", ntIt)),
+				S(con2, N("H", ntIt, SpecPriority(20))),
+			}
+			conf.RandomizeAllSpecs = true
+		})
+
+		It("schedules higher priority groups first, inheriting priority from containers and keeping ordered containers intact", func() {
+			for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+				groupedSpecIndices, serialSpecIndices := internal.OrderSpecs(specs, conf)
+				Ω(serialSpecIndices).Should(BeEmpty())
+				Ω(getTexts(specs, groupedSpecIndices).Join()).Should(Equal("HBCDEGAF"))
+			}
+		})
+
+		It("preserves the randomized order within a given priority", func() {
+			specs = Specs{
+				S(N("A", ntIt, SpecPriority(1))),
+				S(N("B", ntIt, SpecPriority(1))),
+				S(N("C", ntIt, SpecPriority(1))),
+				S(N("D", ntIt)),
+				S(N("E", ntIt)),
+				S(N("F", ntIt)),
+			}
+			orders := map[string]bool{}
+			for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+				groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+				texts := getTexts(specs, groupedSpecIndices)
+				Ω(texts[:3]).Should(ConsistOf("A", "B", "C"))
+				Ω(texts[3:]).Should(ConsistOf("D", "E", "F"))
+				orders[texts.Join()] = true
+
+				againSpecIndices, _ := internal.OrderSpecs(specs, conf)
+				Ω(getTexts(specs, againSpecIndices)).Should(Equal(texts))
+			}
+			Ω(len(orders)).Should(BeNumerically(">", 1))
+		})
+	})
+
 	Context("when there are serial specs", func() {
 		BeforeEach(func() {
 			con1 := N(ntCon, Ordered, Serial)
@@ -246,5 +377,20 @@ var _ = Describe("OrderSpecs", func() {
 				Ω(getTexts(specs, serialSpecIndices1)).ShouldNot(Equal(getTexts(specs, serialSpecIndices2)))
 			})
 		})
+
+		Context("and the tests are running in parallel goroutines", func() {
+			BeforeEach(func() {
+				conf.ParallelTotal = 1
+				conf.ParallelMode = "goroutines"
+				conf.ParallelGoroutines = 2
+			})
+
+			It("puts all parallelizable tests in the parallelizable group and all serial tests in the serial group", func() {
+				groupedSpecIndices, serialSpecIndices := internal.OrderSpecs(specs, conf)
+				Ω(getTexts(specs, groupedSpecIndices)).Should(ConsistOf("B", "F", "G"))
+				Ω(getTexts(specs, serialSpecIndices).Join()).Should(ContainSubstring("CDE"))
+				Ω(getTexts(specs, serialSpecIndices)).Should(ConsistOf("A", "C", "D", "E", "H"))
+			})
+		})
 	})
 })
@@ -15,10 +15,22 @@ type BeforeSuiteState struct {
 	State types.SpecState
 }
 
+type NodeEventReport struct {
+	Report types.SpecReport
+	Event  types.NodeEvent
+}
+
 type ParallelIndexCounter struct {
 	Index int
 }
 
+// SpecGroup describes a group of parallelizable specs to the server so that it can schedule the slowest groups first and honor Exclusive keys
+type SpecGroup struct {
+	SpecIDs       []string
+	Priority      int
+	ExclusiveKeys []string
+}
+
 var ErrorGone = fmt.Errorf("gone")
 var ErrorFailed = fmt.Errorf("failed")
 var ErrorEarly = fmt.Errorf("early")
@@ -33,6 +45,9 @@ type Server interface {
 	GetSuiteDone() chan interface{}
 	GetOutputDestination() io.Writer
 	SetOutputDestination(io.Writer)
+	RequestProgressReport()
+	SetSpecRunTimes(runTimes map[string]time.Duration)
+	GetObservedSpecReports() types.SpecReports
 }
 
 type Client interface {
@@ -41,12 +56,18 @@ type Client interface {
 
 	PostSuiteWillBegin(report types.Report) error
 	PostDidRun(report types.SpecReport) error
+	PostNodeWillRun(report types.SpecReport, event types.NodeEvent) error
+	PostNodeDidRun(report types.SpecReport, event types.NodeEvent) error
 	PostSuiteDidEnd(report types.Report) error
+	PostEmitProgressReport(report types.ProgressReport) error
+	PostProgressReportRequest() error
+	FetchProgressReportRequestCount() (int, error)
 	PostSynchronizedBeforeSuiteCompleted(state types.SpecState, data []byte) error
 	BlockUntilSynchronizedBeforeSuiteData() (types.SpecState, []byte, error)
 	BlockUntilNonprimaryProcsHaveFinished() error
 	BlockUntilAggregatedNonprimaryProcsReport() (types.Report, error)
-	FetchNextCounter() (int, error)
+	PostSpecGroups(groups []SpecGroup) error
+	FetchNextCounter(proc int) (int, error)
 	PostAbort() error
 	ShouldAbort() bool
 	Write(p []byte) (int, error)
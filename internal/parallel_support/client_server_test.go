@@ -192,6 +192,68 @@ var _ = Describe("The Parallel Support Client & Server", func() {
 				})
 			})
 
+			Describe("Emitting progress reports", func() {
+				It("forwards progress reports to the attached reporter", func() {
+					progressReport := types.ProgressReport{
+						ParallelProcess:         2,
+						RunningInParallel:       true,
+						ContainerHierarchyTexts: []string{"A", "B"},
+						LeafNodeText:            "C",
+						CurrentNodeType:         types.NodeTypeIt,
+						SpecGoroutineStack:      "goroutine 17 [running]:",
+					}
+					Ω(client.PostEmitProgressReport(progressReport)).Should(Succeed())
+					Ω(reporter.EmittedProgressReports()).Should(HaveLen(1))
+					Ω(reporter.EmittedProgressReports()[0].ContainerHierarchyTexts).Should(Equal([]string{"A", "B"}))
+					Ω(reporter.EmittedProgressReports()[0].LeafNodeText).Should(Equal("C"))
+					Ω(reporter.EmittedProgressReports()[0].CurrentNodeType).Should(Equal(types.NodeTypeIt))
+					Ω(reporter.EmittedProgressReports()[0].SpecGoroutineStack).Should(Equal("goroutine 17 [running]:"))
+				})
+			})
+
+			Describe("Forwarding node events", func() {
+				var specReport types.SpecReport
+				var willRunEvent, didRunEvent types.NodeEvent
+
+				BeforeEach(func() {
+					specReport = types.SpecReport{LeafNodeText: "A"}
+					willRunEvent = types.NodeEvent{ParallelProcess: 2, NodeType: types.NodeTypeBeforeEach, NodeLocation: types.NewCodeLocation(0)}
+					didRunEvent = types.NodeEvent{ParallelProcess: 2, NodeType: types.NodeTypeBeforeEach, RunTime: time.Second, State: types.SpecStateFailed, Failure: types.Failure{Message: "boom"}}
+
+					Ω(client.PostSuiteWillBegin(types.Report{})).Should(Succeed())
+					Ω(client.PostSuiteWillBegin(types.Report{})).Should(Succeed())
+					Ω(client.PostNodeWillRun(specReport, willRunEvent)).Should(Succeed())
+					Ω(client.PostNodeDidRun(specReport, didRunEvent)).Should(Succeed())
+					Ω(client.PostDidRun(specReport)).Should(Succeed())
+				})
+
+				It("holds on to node events until all procs have reported SuiteWillBegin", func() {
+					Ω(reporter.WillNodes).Should(BeEmpty())
+					Ω(reporter.DidNodes).Should(BeEmpty())
+
+					Ω(client.PostSuiteWillBegin(types.Report{})).Should(Succeed())
+					Ω(reporter.WillNodes).Should(HaveLen(1))
+					Ω(reporter.WillNodes[0].Report.LeafNodeText).Should(Equal("A"))
+					Ω(reporter.WillNodes[0].Event.NodeType).Should(Equal(types.NodeTypeBeforeEach))
+					Ω(reporter.WillNodes[0].Event.NodeLocation).Should(Equal(willRunEvent.NodeLocation))
+					Ω(reporter.WillNodes[0].Event.ParallelProcess).Should(Equal(2))
+
+					Ω(reporter.DidNodes).Should(HaveLen(1))
+					Ω(reporter.DidNodes[0].Event.RunTime).Should(Equal(time.Second))
+					Ω(reporter.DidNodes[0].Event.State).Should(Equal(types.SpecStateFailed))
+					Ω(reporter.DidNodes[0].Event.Failure.Message).Should(Equal("boom"))
+					Ω(reporter.Did.Names()).Should(Equal([]string{"A"}))
+				})
+
+				It("forwards node events immediately once all procs have reported SuiteWillBegin", func() {
+					Ω(client.PostSuiteWillBegin(types.Report{})).Should(Succeed())
+					Ω(client.PostNodeWillRun(types.SpecReport{LeafNodeText: "B"}, types.NodeEvent{NodeType: types.NodeTypeIt})).Should(Succeed())
+					Ω(reporter.WillNodes).Should(HaveLen(2))
+					Ω(reporter.WillNodes[1].Report.LeafNodeText).Should(Equal("B"))
+					Ω(reporter.WillNodes.Types()).Should(Equal([]types.NodeType{types.NodeTypeBeforeEach, types.NodeTypeIt}))
+				})
+			})
+
 			Describe("Streaming output", func() {
 				It("is configured to stream to stdout", func() {
 					server, err := parallel_support.NewServer(3, reporter)
@@ -365,11 +427,143 @@ var _ = Describe("The Parallel Support Client & Server", func() {
 				})
 
 				Describe("Fetching counters", func() {
+					groups := func(specIDs ...[]string) []parallel_support.SpecGroup {
+						out := []parallel_support.SpecGroup{}
+						for _, ids := range specIDs {
+							out = append(out, parallel_support.SpecGroup{SpecIDs: ids})
+						}
+						return out
+					}
+
 					It("returns ascending counters", func() {
-						Ω(client.FetchNextCounter()).Should(Equal(0))
-						Ω(client.FetchNextCounter()).Should(Equal(1))
-						Ω(client.FetchNextCounter()).Should(Equal(2))
-						Ω(client.FetchNextCounter()).Should(Equal(3))
+						Ω(client.FetchNextCounter(1)).Should(Equal(0))
+						Ω(client.FetchNextCounter(1)).Should(Equal(1))
+						Ω(client.FetchNextCounter(1)).Should(Equal(2))
+						Ω(client.FetchNextCounter(1)).Should(Equal(3))
+					})
+
+					Context("when the server has not been given spec run times", func() {
+						It("ignores the spec groups and returns ascending counters", func() {
+							Ω(client.PostSpecGroups(groups([]string{"a"}, []string{"b"}, []string{"c"}))).Should(Succeed())
+							Ω(client.FetchNextCounter(1)).Should(Equal(0))
+							Ω(client.FetchNextCounter(1)).Should(Equal(1))
+							Ω(client.FetchNextCounter(1)).Should(Equal(2))
+						})
+					})
+
+					Context("when the server has been given spec run times", func() {
+						BeforeEach(func() {
+							server.SetSpecRunTimes(map[string]time.Duration{"a": time.Second, "b": 3 * time.Second, "c": 2 * time.Second, "d1": time.Second, "d2": 3 * time.Second})
+						})
+
+						It("hands out the slowest groups first, assuming specs with no run times take the median run time", func() {
+							Ω(client.PostSpecGroups(groups([]string{"new-1"}, []string{"a"}, []string{"b"}, []string{"new-2"}, []string{"c"}, []string{"d1", "d2", "new-3"}, []string{}))).Should(Succeed())
+							counters := []int{}
+							for i := 0; i < 8; i++ {
+								counter, err := client.FetchNextCounter(1)
+								Ω(err).ShouldNot(HaveOccurred())
+								counters = append(counters, counter)
+							}
+							// new specs are estimated at 2s - the median - so they sort alongside "c" in their original order
+							Ω(counters).Should(Equal([]int{5, 2, 0, 3, 4, 1, 6, 7}))
+						})
+
+						It("only reorders groups that have the same priority", func() {
+							Ω(client.PostSpecGroups([]parallel_support.SpecGroup{
+								{SpecIDs: []string{"a"}, Priority: 10},
+								{SpecIDs: []string{"new-1"}, Priority: 10},
+								{SpecIDs: []string{"d1"}, Priority: 10},
+								{SpecIDs: []string{"c"}, Priority: 0},
+								{SpecIDs: []string{"b"}, Priority: 0},
+								{SpecIDs: []string{"d2"}, Priority: -1},
+							})).Should(Succeed())
+							counters := []int{}
+							for i := 0; i < 6; i++ {
+								counter, err := client.FetchNextCounter(1)
+								Ω(err).ShouldNot(HaveOccurred())
+								counters = append(counters, counter)
+							}
+							Ω(counters).Should(Equal([]int{1, 0, 2, 4, 3, 5}))
+						})
+
+						It("only computes the schedule from the first set of groups it receives", func() {
+							Ω(client.PostSpecGroups(groups([]string{"a"}, []string{"b"}))).Should(Succeed())
+							Ω(client.PostSpecGroups(groups([]string{"b"}, []string{"a"}))).Should(Succeed())
+							Ω(client.FetchNextCounter(1)).Should(Equal(1))
+							Ω(client.FetchNextCounter(1)).Should(Equal(0))
+							Ω(client.FetchNextCounter(1)).Should(Equal(2))
+						})
+					})
+				})
+
+				Describe("Leasing Exclusive keys", func() {
+					var proc1Exited chan interface{}
+					var fetchInBackground func(proc int) chan int
+
+					BeforeEach(func() {
+						proc1Exited = make(chan interface{})
+						server.RegisterAlive(1, func() bool {
+							select {
+							case <-proc1Exited:
+								return false
+							default:
+								return true
+							}
+						})
+						fetchInBackground = func(proc int) chan int {
+							counterC := make(chan int, 1)
+							go func() {
+								defer GinkgoRecover()
+								counter, err := client.FetchNextCounter(proc)
+								Ω(err).ShouldNot(HaveOccurred())
+								counterC <- counter
+							}()
+							return counterC
+						}
+						Ω(client.PostSpecGroups([]parallel_support.SpecGroup{
+							{SpecIDs: []string{"a"}, ExclusiveKeys: []string{"db"}},
+							{SpecIDs: []string{"b"}, ExclusiveKeys: []string{"db"}},
+							{SpecIDs: []string{"c"}},
+						})).Should(Succeed())
+					})
+
+					It("does not hand out groups that hold a key leased to another process, preferring groups that don't contend", func() {
+						Ω(client.FetchNextCounter(1)).Should(Equal(0))
+						Ω(client.FetchNextCounter(2)).Should(Equal(2))
+
+						counterC := fetchInBackground(2)
+						Consistently(counterC).ShouldNot(Receive())
+
+						// asking for more work releases process 1's lease on db - and it is free to take the next group that needs db
+						// at which point every group has been handed out and process 2 is done
+						Ω(client.FetchNextCounter(1)).Should(Equal(1))
+						Eventually(counterC).Should(Receive(Equal(3)))
+						Ω(client.FetchNextCounter(1)).Should(Equal(3))
+					})
+
+					It("releases the leases held by a process that exits", func() {
+						Ω(client.FetchNextCounter(1)).Should(Equal(0))
+						Ω(client.FetchNextCounter(2)).Should(Equal(2))
+
+						counterC := fetchInBackground(2)
+						Consistently(counterC).ShouldNot(Receive())
+
+						close(proc1Exited)
+						Eventually(counterC).Should(Receive(Equal(1)))
+					})
+				})
+
+				Describe("Observing spec run times", func() {
+					It("returns trimmed-down reports for the specs that ran", func() {
+						Ω(client.PostDidRun(types.SpecReport{LeafNodeType: types.NodeTypeIt, LeafNodeText: "B", SpecID: "b", State: types.SpecStatePassed, RunTime: time.Second, CapturedGinkgoWriterOutput: "hi"})).Should(Succeed())
+						Ω(client.PostDidRun(types.SpecReport{LeafNodeType: types.NodeTypeIt, LeafNodeText: "A", SpecID: "a", State: types.SpecStateFailed, RunTime: 2 * time.Second})).Should(Succeed())
+						Ω(client.PostDidRun(types.SpecReport{LeafNodeType: types.NodeTypeIt, LeafNodeText: "C", SpecID: "c", State: types.SpecStateSkipped})).Should(Succeed())
+						Ω(client.PostDidRun(types.SpecReport{LeafNodeType: types.NodeTypeBeforeSuite, State: types.SpecStatePassed, RunTime: time.Second})).Should(Succeed())
+
+						Ω(server.GetObservedSpecReports()).Should(Equal(types.SpecReports{
+							{LeafNodeType: types.NodeTypeIt, LeafNodeText: "A", SpecID: "a", State: types.SpecStateFailed, RunTime: 2 * time.Second},
+							{LeafNodeType: types.NodeTypeIt, LeafNodeText: "B", SpecID: "b", State: types.SpecStatePassed, RunTime: time.Second},
+						}))
 					})
 				})
 
@@ -389,6 +583,19 @@ var _ = Describe("The Parallel Support Client & Server", func() {
 					})
 				})
 
+				Describe("Requesting progress reports", func() {
+					It("starts with no requests", func() {
+						Ω(client.FetchProgressReportRequestCount()).Should(Equal(0))
+					})
+
+					It("counts requests made by clients and by the server", func() {
+						Ω(client.PostProgressReportRequest()).Should(Succeed())
+						Ω(client.FetchProgressReportRequestCount()).Should(Equal(1))
+						server.RequestProgressReport()
+						Ω(client.FetchProgressReportRequestCount()).Should(Equal(2))
+					})
+				})
+
 			})
 		})
 	}
@@ -90,10 +90,32 @@ func (client *httpClient) PostDidRun(report types.SpecReport) error {
 	return client.post("/did-run", report)
 }
 
+func (client *httpClient) PostNodeWillRun(report types.SpecReport, event types.NodeEvent) error {
+	return client.post("/node-will-run", NodeEventReport{Report: report, Event: event})
+}
+
+func (client *httpClient) PostNodeDidRun(report types.SpecReport, event types.NodeEvent) error {
+	return client.post("/node-did-run", NodeEventReport{Report: report, Event: event})
+}
+
 func (client *httpClient) PostSuiteDidEnd(report types.Report) error {
 	return client.post("/suite-did-end", report)
 }
 
+func (client *httpClient) PostEmitProgressReport(report types.ProgressReport) error {
+	return client.post("/progress-report", report)
+}
+
+func (client *httpClient) PostProgressReportRequest() error {
+	return client.post("/progress-report-request", nil)
+}
+
+func (client *httpClient) FetchProgressReportRequestCount() (int, error) {
+	var count int
+	err := client.poll("/progress-report-request", &count)
+	return count, err
+}
+
 func (client *httpClient) PostSynchronizedBeforeSuiteCompleted(state types.SpecState, data []byte) error {
 	beforeSuiteState := BeforeSuiteState{
 		State: state,
@@ -124,9 +146,13 @@ func (client *httpClient) BlockUntilAggregatedNonprimaryProcsReport() (types.Rep
 	return report, err
 }
 
-func (client *httpClient) FetchNextCounter() (int, error) {
+func (client *httpClient) PostSpecGroups(groups []SpecGroup) error {
+	return client.post("/spec-groups", groups)
+}
+
+func (client *httpClient) FetchNextCounter(proc int) (int, error) {
 	var counter ParallelIndexCounter
-	err := client.poll("/counter", &counter)
+	err := client.poll(fmt.Sprintf("/counter?proc=%d", proc), &counter)
 	return counter.Index, err
 }
 
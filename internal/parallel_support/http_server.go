@@ -12,6 +12,8 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/onsi-experimental/ginkgo/v2/reporters"
 	"github.com/onsi-experimental/ginkgo/v2/types"
@@ -47,17 +49,22 @@ func (server *httpServer) Start() {
 	//streaming endpoints
 	mux.HandleFunc("/suite-will-begin", server.specSuiteWillBegin)
 	mux.HandleFunc("/did-run", server.didRun)
+	mux.HandleFunc("/node-will-run", server.nodeWillRun)
+	mux.HandleFunc("/node-did-run", server.nodeDidRun)
 	mux.HandleFunc("/suite-did-end", server.specSuiteDidEnd)
 	mux.HandleFunc("/emit-output", server.emitOutput)
+	mux.HandleFunc("/progress-report", server.emitProgressReport)
 
 	//synchronization endpoints
 	mux.HandleFunc("/before-suite-completed", server.handleBeforeSuiteCompleted)
 	mux.HandleFunc("/before-suite-state", server.handleBeforeSuiteState)
 	mux.HandleFunc("/have-nonprimary-procs-finished", server.handleHaveNonprimaryProcsFinished)
 	mux.HandleFunc("/aggregated-nonprimary-procs-report", server.handleAggregatedNonprimaryProcsReport)
+	mux.HandleFunc("/spec-groups", server.handleSpecGroups)
 	mux.HandleFunc("/counter", server.handleCounter)
 	mux.HandleFunc("/up", server.handleUp)
 	mux.HandleFunc("/abort", server.handleAbort)
+	mux.HandleFunc("/progress-report-request", server.handleProgressReportRequest)
 
 	go httpServer.Serve(server.listener)
 }
@@ -84,6 +91,18 @@ func (server *httpServer) SetOutputDestination(w io.Writer) {
 	server.handler.outputDestination = w
 }
 
+func (server *httpServer) RequestProgressReport() {
+	server.handler.RequestProgressReport(voidSender, voidReceiver)
+}
+
+func (server *httpServer) SetSpecRunTimes(runTimes map[string]time.Duration) {
+	server.handler.setSpecRunTimes(runTimes)
+}
+
+func (server *httpServer) GetObservedSpecReports() types.SpecReports {
+	return server.handler.getObservedSpecReports()
+}
+
 func (server *httpServer) RegisterAlive(node int, alive func() bool) {
 	server.handler.registerAlive(node, alive)
 }
@@ -137,6 +156,24 @@ func (server *httpServer) didRun(writer http.ResponseWriter, request *http.Reque
 	server.handleError(server.handler.DidRun(report, voidReceiver), writer)
 }
 
+func (server *httpServer) nodeWillRun(writer http.ResponseWriter, request *http.Request) {
+	var nodeEventReport NodeEventReport
+	if !server.decode(writer, request, &nodeEventReport) {
+		return
+	}
+
+	server.handleError(server.handler.NodeWillRun(nodeEventReport, voidReceiver), writer)
+}
+
+func (server *httpServer) nodeDidRun(writer http.ResponseWriter, request *http.Request) {
+	var nodeEventReport NodeEventReport
+	if !server.decode(writer, request, &nodeEventReport) {
+		return
+	}
+
+	server.handleError(server.handler.NodeDidRun(nodeEventReport, voidReceiver), writer)
+}
+
 func (server *httpServer) specSuiteDidEnd(writer http.ResponseWriter, request *http.Request) {
 	var report types.Report
 	if !server.decode(writer, request, &report) {
@@ -155,6 +192,14 @@ func (server *httpServer) emitOutput(writer http.ResponseWriter, request *http.R
 	server.handleError(server.handler.EmitOutput(output, &n), writer)
 }
 
+func (server *httpServer) emitProgressReport(writer http.ResponseWriter, request *http.Request) {
+	var report types.ProgressReport
+	if !server.decode(writer, request, &report) {
+		return
+	}
+	server.handleError(server.handler.EmitProgressReport(report, voidReceiver), writer)
+}
+
 func (server *httpServer) handleBeforeSuiteCompleted(writer http.ResponseWriter, request *http.Request) {
 	var beforeSuiteState BeforeSuiteState
 	if !server.decode(writer, request, &beforeSuiteState) {
@@ -187,9 +232,21 @@ func (server *httpServer) handleAggregatedNonprimaryProcsReport(writer http.Resp
 	json.NewEncoder(writer).Encode(aggregatedReport)
 }
 
+func (server *httpServer) handleSpecGroups(writer http.ResponseWriter, request *http.Request) {
+	var groups []SpecGroup
+	if !server.decode(writer, request, &groups) {
+		return
+	}
+	server.handleError(server.handler.SpecGroups(groups, voidReceiver), writer)
+}
+
 func (server *httpServer) handleCounter(writer http.ResponseWriter, request *http.Request) {
+	proc, err := strconv.Atoi(request.URL.Query().Get("proc"))
+	if server.handleError(err, writer) {
+		return
+	}
 	var n int
-	if server.handleError(server.handler.Counter(voidSender, &n), writer) {
+	if server.handleError(server.handler.Counter(proc, &n), writer) {
 		return
 	}
 	json.NewEncoder(writer).Encode(ParallelIndexCounter{Index: n})
@@ -212,3 +269,15 @@ func (server *httpServer) handleAbort(writer http.ResponseWriter, request *http.
 		server.handler.Abort(voidSender, voidReceiver)
 	}
 }
+
+func (server *httpServer) handleProgressReportRequest(writer http.ResponseWriter, request *http.Request) {
+	if request.Method == "GET" {
+		var count int
+		if server.handleError(server.handler.ProgressReportRequestCount(voidSender, &count), writer) {
+			return
+		}
+		json.NewEncoder(writer).Encode(count)
+	} else {
+		server.handleError(server.handler.RequestProgressReport(voidSender, voidReceiver), writer)
+	}
+}
@@ -41,8 +41,12 @@ func (client *rpcClient) Close() error {
 }
 
 func (client *rpcClient) poll(method string, data interface{}) error {
+	return client.pollWith(method, voidSender, data)
+}
+
+func (client *rpcClient) pollWith(method string, args interface{}, data interface{}) error {
 	for {
-		err := client.client.Call(method, voidSender, data)
+		err := client.client.Call(method, args, data)
 		if err == nil {
 			return nil
 		}
@@ -67,10 +71,32 @@ func (client *rpcClient) PostDidRun(report types.SpecReport) error {
 	return client.client.Call("Server.DidRun", report, voidReceiver)
 }
 
+func (client *rpcClient) PostNodeWillRun(report types.SpecReport, event types.NodeEvent) error {
+	return client.client.Call("Server.NodeWillRun", NodeEventReport{Report: report, Event: event}, voidReceiver)
+}
+
+func (client *rpcClient) PostNodeDidRun(report types.SpecReport, event types.NodeEvent) error {
+	return client.client.Call("Server.NodeDidRun", NodeEventReport{Report: report, Event: event}, voidReceiver)
+}
+
 func (client *rpcClient) PostSuiteDidEnd(report types.Report) error {
 	return client.client.Call("Server.SpecSuiteDidEnd", report, voidReceiver)
 }
 
+func (client *rpcClient) PostEmitProgressReport(report types.ProgressReport) error {
+	return client.client.Call("Server.EmitProgressReport", report, voidReceiver)
+}
+
+func (client *rpcClient) PostProgressReportRequest() error {
+	return client.client.Call("Server.RequestProgressReport", voidSender, voidReceiver)
+}
+
+func (client *rpcClient) FetchProgressReportRequestCount() (int, error) {
+	var count int
+	err := client.client.Call("Server.ProgressReportRequestCount", voidSender, &count)
+	return count, err
+}
+
 func (client *rpcClient) Write(p []byte) (int, error) {
 	var n int
 	err := client.client.Call("Server.EmitOutput", p, &n)
@@ -107,9 +133,13 @@ func (client *rpcClient) BlockUntilAggregatedNonprimaryProcsReport() (types.Repo
 	return report, err
 }
 
-func (client *rpcClient) FetchNextCounter() (int, error) {
+func (client *rpcClient) PostSpecGroups(groups []SpecGroup) error {
+	return client.client.Call("Server.SpecGroups", groups, voidReceiver)
+}
+
+func (client *rpcClient) FetchNextCounter(proc int) (int, error) {
 	var counter int
-	err := client.client.Call("Server.Counter", voidSender, &counter)
+	err := client.pollWith("Server.Counter", proc, &counter)
 	return counter, err
 }
 
@@ -12,8 +12,10 @@ import (
 	"net"
 	"net/http"
 	"net/rpc"
+	"time"
 
 	"github.com/onsi-experimental/ginkgo/v2/reporters"
+	"github.com/onsi-experimental/ginkgo/v2/types"
 )
 
 /*
@@ -70,6 +72,18 @@ func (server *RPCServer) SetOutputDestination(w io.Writer) {
 	server.handler.outputDestination = w
 }
 
+func (server *RPCServer) RequestProgressReport() {
+	server.handler.RequestProgressReport(voidSender, voidReceiver)
+}
+
+func (server *RPCServer) SetSpecRunTimes(runTimes map[string]time.Duration) {
+	server.handler.setSpecRunTimes(runTimes)
+}
+
+func (server *RPCServer) GetObservedSpecReports() types.SpecReports {
+	return server.handler.getObservedSpecReports()
+}
+
 func (server *RPCServer) RegisterAlive(node int, alive func() bool) {
 	server.handler.registerAlive(node, alive)
 }
@@ -3,7 +3,9 @@ package parallel_support
 import (
 	"io"
 	"os"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/onsi-experimental/ginkgo/v2/reporters"
 	"github.com/onsi-experimental/ginkgo/v2/types"
@@ -27,12 +29,17 @@ type ServerHandler struct {
 	parallelTotal     int
 	counter           int
 	counterLock       *sync.Mutex
+	specRunTimes      map[string]time.Duration
+	workQueue         *WorkQueue
 	shouldAbort       bool
 
+	progressReportRequestCount int
+
 	numSuiteDidBegins int
 	numSuiteDidEnds   int
 	aggregatedReport  types.Report
-	reportHoldingArea []types.SpecReport
+	reportHoldingArea []func()
+	observedSpecs     map[string]types.SpecReport
 }
 
 func newServerHandler(parallelTotal int, reporter reporters.Reporter) *ServerHandler {
@@ -45,6 +52,7 @@ func newServerHandler(parallelTotal int, reporter reporters.Reporter) *ServerHan
 		parallelTotal:     parallelTotal,
 		outputDestination: os.Stdout,
 		done:              make(chan interface{}),
+		observedSpecs:     map[string]types.SpecReport{},
 	}
 }
 
@@ -58,9 +66,8 @@ func (handler *ServerHandler) SpecSuiteWillBegin(report types.Report, _ *Void) e
 	if handler.numSuiteDidBegins == handler.parallelTotal {
 		handler.reporter.SuiteWillBegin(report)
 
-		for _, summary := range handler.reportHoldingArea {
-			handler.reporter.WillRun(summary)
-			handler.reporter.DidRun(summary)
+		for _, emit := range handler.reportHoldingArea {
+			emit()
 		}
 
 		handler.reportHoldingArea = nil
@@ -73,16 +80,68 @@ func (handler *ServerHandler) DidRun(report types.SpecReport, _ *Void) error {
 	handler.lock.Lock()
 	defer handler.lock.Unlock()
 
-	if handler.numSuiteDidBegins == handler.parallelTotal {
+	if report.SpecID != "" && report.LeafNodeType.Is(types.NodeTypeIt) && !report.State.Is(types.SpecStateSkipped|types.SpecStatePending) {
+		handler.observedSpecs[report.SpecID] = types.SpecReport{
+			ContainerHierarchyTexts: report.ContainerHierarchyTexts,
+			LeafNodeType:            report.LeafNodeType,
+			LeafNodeLocation:        report.LeafNodeLocation,
+			LeafNodeText:            report.LeafNodeText,
+			SpecID:                  report.SpecID,
+			State:                   report.State,
+			RunTime:                 report.RunTime,
+		}
+	}
+
+	handler.emitOrHold(func() {
 		handler.reporter.WillRun(report)
 		handler.reporter.DidRun(report)
-	} else {
-		handler.reportHoldingArea = append(handler.reportHoldingArea, report)
+	})
+
+	return nil
+}
+
+func (handler *ServerHandler) NodeWillRun(nodeEventReport NodeEventReport, _ *Void) error {
+	nodeReporter, ok := handler.reporter.(reporters.NodeReporter)
+	if !ok {
+		return nil
+	}
+
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+
+	handler.emitOrHold(func() {
+		nodeReporter.NodeWillRun(nodeEventReport.Report, nodeEventReport.Event)
+	})
+
+	return nil
+}
+
+func (handler *ServerHandler) NodeDidRun(nodeEventReport NodeEventReport, _ *Void) error {
+	nodeReporter, ok := handler.reporter.(reporters.NodeReporter)
+	if !ok {
+		return nil
 	}
 
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+
+	handler.emitOrHold(func() {
+		nodeReporter.NodeDidRun(nodeEventReport.Report, nodeEventReport.Event)
+	})
+
 	return nil
 }
 
+// emitOrHold must be called with the lock held.  Reporter events are held until all processes have reported SuiteWillBegin
+// so that the reporter always sees SuiteWillBegin first.  Held events are emitted in the order they were received.
+func (handler *ServerHandler) emitOrHold(emit func()) {
+	if handler.numSuiteDidBegins == handler.parallelTotal {
+		emit()
+	} else {
+		handler.reportHoldingArea = append(handler.reportHoldingArea, emit)
+	}
+}
+
 func (handler *ServerHandler) SpecSuiteDidEnd(report types.Report, _ *Void) error {
 	handler.lock.Lock()
 	defer handler.lock.Unlock()
@@ -102,6 +161,18 @@ func (handler *ServerHandler) SpecSuiteDidEnd(report types.Report, _ *Void) erro
 	return nil
 }
 
+func (handler *ServerHandler) EmitProgressReport(report types.ProgressReport, _ *Void) error {
+	progressReporter, ok := handler.reporter.(reporters.ProgressReporter)
+	if !ok {
+		return nil
+	}
+
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+	progressReporter.EmitProgressReport(report)
+	return nil
+}
+
 func (handler *ServerHandler) EmitOutput(output []byte, n *int) error {
 	var err error
 	*n, err = handler.outputDestination.Write(output)
@@ -179,14 +250,51 @@ func (handler *ServerHandler) AggregatedNonprimaryProcsReport(_ Void, report *ty
 	}
 }
 
-func (handler *ServerHandler) Counter(_ Void, counter *int) error {
+// SpecGroups receives the groups of parallelizable specs - in the order the processes would fetch them with Counter.
+// The server uses them to build the work queue: if it has been given the run times of a previous run it hands out the slowest groups first,
+// and it never hands out two groups that hold the same Exclusive key at the same time.
+// Every process sends the same groups so the server only builds the work queue once.
+func (handler *ServerHandler) SpecGroups(groups []SpecGroup, _ *Void) error {
 	handler.counterLock.Lock()
 	defer handler.counterLock.Unlock()
-	*counter = handler.counter
-	handler.counter++
+	if handler.workQueue != nil {
+		return nil
+	}
+	order := make([]int, len(groups))
+	for idx := range order {
+		order[idx] = idx
+	}
+	if len(handler.specRunTimes) > 0 {
+		order = scheduleLongestFirst(groups, handler.specRunTimes)
+	}
+	handler.workQueue = NewWorkQueue(groups, order)
 	return nil
 }
 
+// Counter returns the index of the next group of specs the process should run.  Asking for the next group releases the process's Exclusive leases.
+func (handler *ServerHandler) Counter(proc int, counter *int) error {
+	handler.counterLock.Lock()
+	defer handler.counterLock.Unlock()
+	if handler.workQueue == nil {
+		*counter = handler.counter
+		handler.counter++
+		return nil
+	}
+
+	idx, err := handler.workQueue.Next(proc)
+	if err == ErrorEarly {
+		// a process that exits while holding leases will never ask for more work - so we release its leases on its behalf
+		for _, holder := range handler.workQueue.LeaseHolders() {
+			if !handler.procIsAlive(holder) {
+				handler.workQueue.Release(holder)
+			}
+		}
+		idx, err = handler.workQueue.Next(proc)
+	}
+	*counter = idx
+	return err
+}
+
 func (handler *ServerHandler) Abort(_ Void, _ *Void) error {
 	handler.lock.Lock()
 	defer handler.lock.Unlock()
@@ -194,9 +302,80 @@ func (handler *ServerHandler) Abort(_ Void, _ *Void) error {
 	return nil
 }
 
+func (handler *ServerHandler) RequestProgressReport(_ Void, _ *Void) error {
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+	handler.progressReportRequestCount += 1
+	return nil
+}
+
+func (handler *ServerHandler) ProgressReportRequestCount(_ Void, count *int) error {
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+	*count = handler.progressReportRequestCount
+	return nil
+}
+
 func (handler *ServerHandler) ShouldAbort(_ Void, shouldAbort *bool) error {
 	handler.lock.Lock()
 	defer handler.lock.Unlock()
 	*shouldAbort = handler.shouldAbort
 	return nil
 }
+
+func (handler *ServerHandler) setSpecRunTimes(runTimes map[string]time.Duration) {
+	handler.counterLock.Lock()
+	defer handler.counterLock.Unlock()
+	handler.specRunTimes = runTimes
+}
+
+// getObservedSpecReports returns trimmed-down reports for the specs that have run - just enough to schedule a subsequent run
+func (handler *ServerHandler) getObservedSpecReports() types.SpecReports {
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+	reports := types.SpecReports{}
+	for _, report := range handler.observedSpecs {
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].SpecID < reports[j].SpecID })
+	return reports
+}
+
+// scheduleLongestFirst returns the indices of the groups with the slowest groups first.  Specs with no recorded run times (e.g. new specs)
+// are assumed to take the median recorded run time so they are spread through the schedule, and groups with the same estimated run time retain their
+// (randomized) order.  SpecPriority takes precedence: groups are only reordered among groups with the same priority.
+func scheduleLongestFirst(groups []SpecGroup, runTimes map[string]time.Duration) []int {
+	estimate := medianRunTime(runTimes)
+	queue := make([]int, len(groups))
+	durations := make([]time.Duration, len(groups))
+	for idx, group := range groups {
+		queue[idx] = idx
+		for _, id := range group.SpecIDs {
+			if runTime, ok := runTimes[id]; ok {
+				durations[idx] += runTime
+			} else {
+				durations[idx] += estimate
+			}
+		}
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		a, b := queue[i], queue[j]
+		if groups[a].Priority != groups[b].Priority {
+			return groups[a].Priority > groups[b].Priority
+		}
+		return durations[a] > durations[b]
+	})
+	return queue
+}
+
+func medianRunTime(runTimes map[string]time.Duration) time.Duration {
+	if len(runTimes) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, 0, len(runTimes))
+	for _, runTime := range runTimes {
+		sorted = append(sorted, runTime)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[len(sorted)/2]
+}
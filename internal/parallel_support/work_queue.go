@@ -0,0 +1,86 @@
+package parallel_support
+
+/*
+WorkQueue hands out groups of parallelizable specs to workers (parallel processes or goroutines) in the scheduled order.
+
+Groups can hold Exclusive keys.  When a group is dispatched its worker is granted a lease on each of the group's keys and no other
+group holding any of those keys is dispatched until the worker asks for more work (or is released).  Rather than wait for a lease, the
+WorkQueue skips past groups that contend for a held key and dispatches the next group that doesn't.
+
+WorkQueue is not safe for concurrent use - callers are expected to synchronize access.
+*/
+type WorkQueue struct {
+	groups     []SpecGroup
+	order      []int
+	dispatched []bool
+	leases     map[string]int
+	held       map[int][]string
+}
+
+// NewWorkQueue returns a WorkQueue that dispatches the groups in the passed-in order (a permutation of the groups' indices)
+func NewWorkQueue(groups []SpecGroup, order []int) *WorkQueue {
+	return &WorkQueue{
+		groups:     groups,
+		order:      order,
+		dispatched: make([]bool, len(groups)),
+		leases:     map[string]int{},
+		held:       map[int][]string{},
+	}
+}
+
+/*
+Next releases any leases held by the worker and returns the index of the next group it should run.
+
+Next returns len(groups) once every group has been dispatched.  If groups remain but they all need a key leased to another worker, Next returns ErrorEarly and the worker should try again later.
+*/
+func (q *WorkQueue) Next(worker int) (int, error) {
+	q.Release(worker)
+	remaining := false
+	for _, idx := range q.order {
+		if q.dispatched[idx] {
+			continue
+		}
+		remaining = true
+		if q.isContended(q.groups[idx].ExclusiveKeys) {
+			continue
+		}
+		q.dispatched[idx] = true
+		for _, key := range q.groups[idx].ExclusiveKeys {
+			q.leases[key] = worker
+		}
+		q.held[worker] = q.groups[idx].ExclusiveKeys
+		return idx, nil
+	}
+	if remaining {
+		return 0, ErrorEarly
+	}
+	return len(q.groups), nil
+}
+
+// Release releases any leases held by the worker
+func (q *WorkQueue) Release(worker int) {
+	for _, key := range q.held[worker] {
+		delete(q.leases, key)
+	}
+	delete(q.held, worker)
+}
+
+// LeaseHolders returns the workers currently holding leases
+func (q *WorkQueue) LeaseHolders() []int {
+	holders := []int{}
+	for worker, keys := range q.held {
+		if len(keys) > 0 {
+			holders = append(holders, worker)
+		}
+	}
+	return holders
+}
+
+func (q *WorkQueue) isContended(keys []string) bool {
+	for _, key := range keys {
+		if _, held := q.leases[key]; held {
+			return true
+		}
+	}
+	return false
+}
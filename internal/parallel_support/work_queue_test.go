@@ -0,0 +1,80 @@
+package parallel_support_test
+
+import (
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi-experimental/ginkgo/v2/internal/parallel_support"
+)
+
+var _ = Describe("WorkQueue", func() {
+	var queue *parallel_support.WorkQueue
+
+	group := func(keys ...string) parallel_support.SpecGroup {
+		return parallel_support.SpecGroup{ExclusiveKeys: keys}
+	}
+
+	Context("when no groups hold Exclusive keys", func() {
+		BeforeEach(func() {
+			queue = parallel_support.NewWorkQueue([]parallel_support.SpecGroup{group(), group(), group()}, []int{2, 0, 1})
+		})
+
+		It("hands out the groups in the scheduled order, then signals that it is done", func() {
+			Ω(queue.Next(1)).Should(Equal(2))
+			Ω(queue.Next(2)).Should(Equal(0))
+			Ω(queue.Next(1)).Should(Equal(1))
+			Ω(queue.Next(2)).Should(Equal(3))
+			Ω(queue.Next(1)).Should(Equal(3))
+		})
+	})
+
+	Context("when groups hold Exclusive keys", func() {
+		BeforeEach(func() {
+			queue = parallel_support.NewWorkQueue([]parallel_support.SpecGroup{
+				group("db"),
+				group("db", "port"),
+				group(),
+				group("port"),
+				group("db"),
+			}, []int{0, 1, 2, 3, 4})
+		})
+
+		It("skips past groups that contend for a leased key and hands out groups that don't", func() {
+			Ω(queue.Next(1)).Should(Equal(0)) // 1 holds db
+			Ω(queue.Next(2)).Should(Equal(2)) // 1 and 4 need db
+			Ω(queue.Next(3)).Should(Equal(3)) // 3 holds port
+			_, err := queue.Next(4)           // 1 and 4 need db
+			Ω(err).Should(Equal(parallel_support.ErrorEarly))
+			Ω(queue.LeaseHolders()).Should(ConsistOf(1, 3))
+		})
+
+		It("releases a worker's leases when it asks for more work", func() {
+			Ω(queue.Next(1)).Should(Equal(0))
+			Ω(queue.Next(2)).Should(Equal(2))
+			Ω(queue.Next(3)).Should(Equal(3))
+			Ω(queue.Next(1)).Should(Equal(4)) // 1 releases db, but 3 still holds port so group 1 is still contended
+			_, err := queue.Next(3)           // 3 releases port, but 1 now holds db again
+			Ω(err).Should(Equal(parallel_support.ErrorEarly))
+			Ω(queue.LeaseHolders()).Should(ConsistOf(1))
+		})
+
+		It("dispatches contended groups once their keys are released", func() {
+			Ω(queue.Next(1)).Should(Equal(0))
+			Ω(queue.Next(2)).Should(Equal(2))
+			Ω(queue.Next(3)).Should(Equal(3))
+
+			queue.Release(3)
+			_, err := queue.Next(2)
+			Ω(err).Should(Equal(parallel_support.ErrorEarly))
+
+			queue.Release(1)
+			Ω(queue.Next(2)).Should(Equal(1))
+			_, err = queue.Next(3)
+			Ω(err).Should(Equal(parallel_support.ErrorEarly))
+			Ω(queue.Next(2)).Should(Equal(4))
+			Ω(queue.Next(3)).Should(Equal(5))
+			Ω(queue.Next(2)).Should(Equal(5))
+			Ω(queue.LeaseHolders()).Should(BeEmpty())
+		})
+	})
+})
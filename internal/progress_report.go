@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+// PROGRESS_REPORT_REQUEST_POLLING_INTERVAL controls how often Ginkgo processes running in parallel check for progress reports requested by other processes
+var PROGRESS_REPORT_REQUEST_POLLING_INTERVAL = 500 * time.Millisecond
+
+const progressReportGinkgoWriterTailLines = 10
+
+// ProgressStepCursor tracks the most recent By step emitted by the running spec
+type ProgressStepCursor struct {
+	Text         string
+	CodeLocation types.CodeLocation
+	StartTime    time.Time
+}
+
+type ProgressSignalRegistrar func(func()) context.CancelFunc
+
+/*
+RegisterForProgressSignal calls handler whenever the process receives one of the PROGRESS_SIGNALS (SIGINFO and/or SIGUSR1, depending on the platform).
+
+Calling the returned CancelFunc stops listening for the signals.
+*/
+func RegisterForProgressSignal(handler func()) context.CancelFunc {
+	return registerForSignals(PROGRESS_SIGNALS, handler)
+}
+
+/*
+RegisterForDirectedProgressSignal is like RegisterForProgressSignal but only listens for the DIRECTED_PROGRESS_SIGNALS - the progress signals that are
+sent to an individual process and not to the terminal's entire foreground process group.  Use it in processes that the Ginkgo CLI already signals on the user's behalf.
+*/
+func RegisterForDirectedProgressSignal(handler func()) context.CancelFunc {
+	return registerForSignals(DIRECTED_PROGRESS_SIGNALS, handler)
+}
+
+func registerForSignals(signals []os.Signal, handler func()) context.CancelFunc {
+	signalChannel := make(chan os.Signal, 1)
+	if len(signals) > 0 {
+		signal.Notify(signalChannel, signals...)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for {
+			select {
+			case <-signalChannel:
+				handler()
+			case <-ctx.Done():
+				signal.Stop(signalChannel)
+				return
+			}
+		}
+	}()
+
+	return cancel
+}
+
+func NewProgressReport(isRunningInParallel bool, report types.SpecReport, currentNode Node, currentNodeStartTime time.Time, currentStep ProgressStepCursor, gwOutput string, goroutineID uint64) types.ProgressReport {
+	pr := types.ProgressReport{
+		ParallelProcess:   report.ParallelProcess,
+		RunningInParallel: isRunningInParallel,
+	}
+	if currentNode.IsZero() {
+		return pr
+	}
+
+	pr.ContainerHierarchyTexts = report.ContainerHierarchyTexts
+	pr.LeafNodeType = report.LeafNodeType
+	pr.LeafNodeText = report.LeafNodeText
+	pr.LeafNodeLocation = report.LeafNodeLocation
+	pr.SpecStartTime = report.StartTime
+
+	pr.CurrentNodeType = currentNode.NodeType
+	pr.CurrentNodeText = currentNode.Text
+	pr.CurrentNodeLocation = currentNode.CodeLocation
+	pr.CurrentNodeStartTime = currentNodeStartTime
+
+	// the step cursor is not reset between specs - we only include it if it was set by the running spec
+	if !currentStep.StartTime.Before(report.StartTime) {
+		pr.CurrentStepText = currentStep.Text
+		pr.CurrentStepLocation = currentStep.CodeLocation
+		pr.CurrentStepStartTime = currentStep.StartTime
+	}
+
+	pr.CapturedGinkgoWriterOutput = tailLines(gwOutput, progressReportGinkgoWriterTailLines)
+	if goroutineID != 0 {
+		pr.SpecGoroutineStack = StackTraceForGoroutine(goroutineID)
+	}
+
+	return pr
+}
+
+func tailLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,14 @@
+//go:build freebsd || openbsd || netbsd || darwin || dragonfly
+// +build freebsd openbsd netbsd darwin dragonfly
+
+package internal
+
+import (
+	"os"
+	"syscall"
+)
+
+var PROGRESS_SIGNALS = []os.Signal{syscall.SIGINFO, syscall.SIGUSR1}
+
+// ^T sends SIGINFO to every process in the terminal's foreground process group - so SIGINFO is not a directed signal
+var DIRECTED_PROGRESS_SIGNALS = []os.Signal{syscall.SIGUSR1}
@@ -0,0 +1,12 @@
+//go:build linux || solaris
+// +build linux solaris
+
+package internal
+
+import (
+	"os"
+	"syscall"
+)
+
+var PROGRESS_SIGNALS = []os.Signal{syscall.SIGUSR1}
+var DIRECTED_PROGRESS_SIGNALS = []os.Signal{syscall.SIGUSR1}
@@ -0,0 +1,9 @@
+//go:build windows
+// +build windows
+
+package internal
+
+import "os"
+
+var PROGRESS_SIGNALS = []os.Signal{}
+var DIRECTED_PROGRESS_SIGNALS = []os.Signal{}
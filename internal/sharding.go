@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+/*
+	Ginkgo can split a suite's specs across multiple machines with --shard-index and --shard-total.
+
+	Sharding operates on the groups generated by OrderSpecs.  Specs in an Ordered container are always assigned to the same shard while all other specs
+	(including Serial specs) are assigned to shards individually.  Each group is assigned to a shard using a hash of the random seed and the group's stable
+	identity - the spec's ID or, for Ordered containers, the identity of the outermost Ordered container (disambiguated, like spec IDs, when
+	several containers share the same text).  This ensures that every machine computes the same
+	assignment without coordinating and that a group's shard does not change as other specs are added to or removed from the suite.
+
+	This function sets the `Skip` property on specs that are not assigned to the configured shard.  Those specs are then reported as skipped which allows
+	the reports generated by each shard to be merged into one complete report.
+*/
+func ApplyShardToSpecs(specs Specs, suiteConfig types.SuiteConfig) Specs {
+	if suiteConfig.ShardTotal <= 1 {
+		return specs
+	}
+
+	groupedSpecIndices, serialGroupedSpecIndices := OrderSpecs(specs, suiteConfig)
+	allGroups := append(append(GroupedSpecIndices{}, groupedSpecIndices...), serialGroupedSpecIndices...)
+	identities := shardIdentitiesOfGroups(specs, allGroups)
+	for _, group := range allGroups {
+		if shardForIdentity(identities[group[0]], suiteConfig) == suiteConfig.ShardIndex {
+			continue
+		}
+		for _, idx := range group {
+			specs[idx].Skip = true
+		}
+	}
+
+	return specs
+}
+
+// shardIdentitiesOfGroups returns the stable identity of each group, keyed by the index of the group's first spec.
+// Like spec IDs, duplicate identities (e.g. Ordered containers generated in a loop) are disambiguated by the order in which they appear in the suite.
+func shardIdentitiesOfGroups(specs Specs, groups GroupedSpecIndices) map[int]string {
+	firstIndices := make([]int, len(groups))
+	for i, group := range groups {
+		firstIndices[i] = group[0]
+	}
+	sort.Ints(firstIndices)
+
+	identities := map[int]string{}
+	seen := map[string]int{}
+	for _, idx := range firstIndices {
+		spec := specs[idx]
+		identity := spec.ID
+		if orderedContainer := spec.Nodes.FirstNodeMarkedOrdered(); !orderedContainer.IsZero() {
+			identity = identityOfGroup(spec, orderedContainer)
+			seen[identity] += 1
+			if seen[identity] > 1 {
+				identity = fmt.Sprintf("%s\x00%d", identity, seen[identity])
+			}
+		}
+		identities[idx] = identity
+	}
+	return identities
+}
+
+// shardForIdentity returns the (one-indexed) shard a group with the given identity is assigned to
+func shardForIdentity(identity string, suiteConfig types.SuiteConfig) int {
+	// we salt the identity so that a group's shard is not correlated with its position when ordering by identity
+	return int(seededHash(suiteConfig.RandomSeed, "shard\x00"+identity)%uint64(suiteConfig.ShardTotal)) + 1
+}
@@ -0,0 +1,143 @@
+package internal_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi-experimental/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi-experimental/ginkgo/v2/internal"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+var _ = Describe("ApplyShardToSpecs", func() {
+	var conf types.SuiteConfig
+	var generateSpecs func(extraSpecs ...Spec) Specs
+
+	runningSpecTexts := func(specs Specs) []string {
+		out := []string{}
+		for _, spec := range specs {
+			if !spec.Skip {
+				out = append(out, spec.Text())
+			}
+		}
+		return out
+	}
+
+	shard := func(index int, specs Specs) []string {
+		shardConf := conf
+		shardConf.ShardIndex = index
+		specsCopy := make(Specs, len(specs))
+		copy(specsCopy, specs)
+		return runningSpecTexts(internal.ApplyShardToSpecs(specsCopy, shardConf))
+	}
+
+	BeforeEach(func() {
+		conf = types.SuiteConfig{RandomSeed: 1, ParallelTotal: 1, ShardIndex: 1, ShardTotal: 3}
+		generateSpecs = func(extraSpecs ...Spec) Specs {
+			con := N(ntCon, "con", CL("file_a", 1))
+			ordered := N(ntCon, "ordered", Ordered, CL("file_a", 20))
+			specs := Specs{}
+			for i := 0; i < 20; i++ {
+				specs = append(specs, S(con, N(fmt.Sprintf("%d", i), ntIt, CL("file_a", 2+i))))
+			}
+			specs = append(specs,
+				S(ordered, N("O1", ntIt, CL("file_a", 21))),
+				S(ordered, N("O2", ntIt, CL("file_a", 22))),
+				S(ordered, N("O3", ntIt, CL("file_a", 23))),
+				S(N("serial", ntIt, Serial, CL("file_a", 30))),
+			)
+			specs = append(specs, extraSpecs...)
+			specs.AssignIDs()
+			return specs
+		}
+	})
+
+	Context("when not sharding", func() {
+		It("does not skip any specs", func() {
+			conf.ShardIndex, conf.ShardTotal = 1, 1
+			specs := generateSpecs()
+			Ω(runningSpecTexts(internal.ApplyShardToSpecs(specs, conf))).Should(HaveLen(24))
+		})
+	})
+
+	It("assigns every spec to exactly one shard", func() {
+		specs := generateSpecs()
+		allTexts := runningSpecTexts(specs)
+		shardedTexts := []string{}
+		for index := 1; index <= conf.ShardTotal; index++ {
+			texts := shard(index, specs)
+			Ω(texts).ShouldNot(BeEmpty())
+			shardedTexts = append(shardedTexts, texts...)
+		}
+		Ω(shardedTexts).Should(ConsistOf(allTexts))
+	})
+
+	It("keeps the specs in Ordered containers on the same shard", func() {
+		specs := generateSpecs()
+		found := 0
+		for index := 1; index <= conf.ShardTotal; index++ {
+			texts := shard(index, specs)
+			hasO1, _ := ContainElement("ordered O1").Match(texts)
+			if hasO1 {
+				found += 1
+				Ω(texts).Should(ContainElements("ordered O2", "ordered O3"))
+			} else {
+				Ω(texts).ShouldNot(ContainElement("ordered O2"))
+				Ω(texts).ShouldNot(ContainElement("ordered O3"))
+			}
+		}
+		Ω(found).Should(Equal(1))
+	})
+
+	It("spreads Ordered containers with identical text across shards", func() {
+		specs := Specs{}
+		for i := 0; i < 12; i++ {
+			ordered := N(ntCon, "ordered", Ordered, CL("file_a", 1))
+			specs = append(specs, S(ordered, N("A", ntIt, CL("file_a", 2))), S(ordered, N("B", ntIt, CL("file_a", 3))))
+		}
+		specs.AssignIDs()
+		for index := 1; index <= conf.ShardTotal; index++ {
+			texts := shard(index, specs)
+			Ω(texts).ShouldNot(BeEmpty())
+			Ω(len(texts) % 2).Should(BeZero())
+		}
+	})
+
+	It("does not run specs that are already skipped on any shard", func() {
+		specs := generateSpecs()
+		specs[0].Skip = true
+		for index := 1; index <= conf.ShardTotal; index++ {
+			Ω(shard(index, specs)).ShouldNot(ContainElement("con 0"))
+		}
+	})
+
+	It("computes the same assignment for the same seed, and a different assignment for a different seed", func() {
+		specs := generateSpecs()
+		Ω(shard(1, specs)).Should(Equal(shard(1, specs)))
+
+		assignment := shard(1, specs)
+		conf.RandomSeed = 2
+		Ω(shard(1, specs)).ShouldNot(Equal(assignment))
+	})
+
+	It("does not reassign existing specs when specs are added or line numbers change", func() {
+		specs := generateSpecs()
+		moreSpecs := generateSpecs(S(N("new spec A", ntIt, CL("file_b", 1))), S(N("new spec B", ntIt, CL("file_b", 2))))
+		for i := range moreSpecs {
+			for j := range moreSpecs[i].Nodes {
+				moreSpecs[i].Nodes[j].CodeLocation.LineNumber += 10
+			}
+		}
+		for index := 1; index <= conf.ShardTotal; index++ {
+			texts := shard(index, moreSpecs)
+			withoutNewSpecs := []string{}
+			for _, text := range texts {
+				if text != "new spec A" && text != "new spec B" {
+					withoutNewSpecs = append(withoutNewSpecs, text)
+				}
+			}
+			Ω(withoutNewSpecs).Should(Equal(shard(index, specs)))
+		}
+	})
+})
@@ -1,13 +1,19 @@
 package internal
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/onsi-experimental/ginkgo/v2/types"
 )
 
 type Spec struct {
 	Nodes Nodes
+	ID    string
 	Skip  bool
 }
 
@@ -36,6 +42,47 @@ func (s Spec) FlakeAttempts() int {
 	return flakeAttempts
 }
 
+func (s Spec) MustPassRepeatedly() int {
+	mustPassRepeatedly := 0
+	for i := range s.Nodes {
+		if s.Nodes[i].MustPassRepeatedly > 0 {
+			mustPassRepeatedly = s.Nodes[i].MustPassRepeatedly
+		}
+	}
+
+	return mustPassRepeatedly
+}
+
+func (s Spec) SpecPriority() int {
+	specPriority := 0
+	for i := range s.Nodes {
+		if s.Nodes[i].SpecPriority != 0 {
+			specPriority = s.Nodes[i].SpecPriority
+		}
+	}
+
+	return specPriority
+}
+
+// ExclusiveKeys returns the union of the Exclusive keys in the spec's node hierarchy
+func (s Spec) ExclusiveKeys() []string {
+	keys := []string{}
+	keysSeen := map[string]bool{}
+	for i := range s.Nodes {
+		for _, key := range s.Nodes[i].ExclusiveKeys {
+			if !keysSeen[key] {
+				keysSeen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+func (s Spec) SpecTimeout() time.Duration {
+	return s.FirstNodeWithType(types.NodeTypeIt).SpecTimeout
+}
+
 type Specs []Spec
 
 func (s Specs) HasAnySpecsMarkedPending() bool {
@@ -65,3 +112,32 @@ func (s Specs) AtIndices(indices SpecIndices) Specs {
 	}
 	return out
 }
+
+/*
+AssignIDs gives each spec a stable ID.  The ID is derived from the texts of the spec's containers and subject node (for table entries this
+includes the entry's description) and from the name of the file the subject node is declared in.  It does not depend on the order in
+which specs are declared so it survives adding, removing, and reordering other specs.
+
+Specs that share the same texts and file are disambiguated, in declaration order, with a -N suffix.
+*/
+func (s Specs) AssignIDs() {
+	seen := map[string]int{}
+	for i := range s {
+		id := s[i].stableID()
+		seen[id] += 1
+		if seen[id] > 1 {
+			id = fmt.Sprintf("%s-%d", id, seen[id])
+		}
+		s[i].ID = id
+	}
+}
+
+func (s Spec) stableID() string {
+	hash := sha256.New()
+	hash.Write([]byte(filepath.Base(s.FirstNodeWithType(types.NodeTypeIt).CodeLocation.FileName)))
+	for _, text := range s.Nodes.WithType(types.NodeTypesForContainerAndIt).Texts() {
+		hash.Write([]byte{0})
+		hash.Write([]byte(text))
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:12]
+}
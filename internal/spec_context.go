@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+type SpecContext interface {
+	context.Context
+
+	SpecReport() types.SpecReport
+}
+
+type specContext struct {
+	context.Context
+
+	cancel context.CancelFunc
+	suite  *Suite
+}
+
+/*
+SpecContext includes a reference to `suite` - this is used to fetch the current spec report when SpecReport() is called.
+
+The context is cancelled when the node it was handed to times out or the suite is interrupted.  Ginkgo always cancels the context once the node returns.
+*/
+func NewSpecContext(suite *Suite, deadline time.Time) *specContext {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if deadline.IsZero() {
+		ctx, cancel = context.WithCancel(context.Background())
+	} else {
+		ctx, cancel = context.WithDeadline(context.Background(), deadline)
+	}
+	return &specContext{
+		Context: ctx,
+		cancel:  cancel,
+		suite:   suite,
+	}
+}
+
+func (sc *specContext) SpecReport() types.SpecReport {
+	return sc.suite.CurrentSpecReport()
+}
@@ -69,6 +69,15 @@ var _ = Describe("Spec and Specs", func() {
 		})
 	})
 
+	Describe("spec.ExclusiveKeys", func() {
+		It("returns the union of the keys in the spec's node hierarchy", func() {
+			Ω(S(N(ntCon), N(ntIt)).ExclusiveKeys()).Should(BeEmpty())
+
+			spec := S(N(ntCon, Exclusive("db")), N(ntCon), N(ntIt, Exclusive("port", "db")))
+			Ω(spec.ExclusiveKeys()).Should(Equal([]string{"db", "port"}))
+		})
+	})
+
 	Describe("specs.HasAnySpecsMarkedPending", func() {
 		Context("when there are no specs with any nodes marked pending", func() {
 			It("returns false", func() {
@@ -107,4 +116,41 @@ var _ = Describe("Spec and Specs", func() {
 			Ω(specs.AtIndices(internal.SpecIndices{1, 3})).Should(Equal(Specs{specs[1], specs[3]}))
 		})
 	})
+
+	Describe("specs.AssignIDs()", func() {
+		var specs Specs
+		BeforeEach(func() {
+			specs = Specs{
+				S(N(ntCon, "A", CL("/path/to/a_test.go", 1)), N(ntBef), N(ntIt, "1", CL("/path/to/a_test.go", 3))),
+				S(N(ntCon, "A", CL("/path/to/a_test.go", 1)), N(ntIt, "2", CL("/path/to/a_test.go", 5))),
+				S(N(ntCon, "B", CL("/path/to/a_test.go", 8)), N(ntIt, "1", CL("/path/to/a_test.go", 9))),
+				S(N(ntCon, "A", CL("/path/to/b_test.go", 1)), N(ntIt, "1", CL("/path/to/b_test.go", 3))),
+				S(N(ntCon, "A", CL("/path/to/a_test.go", 1)), N(ntIt, "1", CL("/path/to/a_test.go", 12))),
+			}
+			specs.AssignIDs()
+		})
+
+		It("assigns each spec a distinct ID", func() {
+			ids := map[string]bool{}
+			for _, spec := range specs {
+				Ω(spec.ID).ShouldNot(BeEmpty())
+				ids[spec.ID] = true
+			}
+			Ω(ids).Should(HaveLen(5))
+		})
+
+		It("disambiguates specs with the same texts in the same file with a suffix", func() {
+			Ω(specs[4].ID).Should(Equal(specs[0].ID + "-2"))
+		})
+
+		It("derives IDs from the spec's texts and file name, and not from its line numbers, setup nodes, or declaration order", func() {
+			others := Specs{
+				S(N(ntCon, "B", CL("/other/path/to/a_test.go", 20)), N(ntIt, "1", CL("/other/path/to/a_test.go", 21))),
+				S(N(ntCon, "A", CL("/other/path/to/a_test.go", 30)), N(ntIt, "1", CL("/other/path/to/a_test.go", 31))),
+			}
+			others.AssignIDs()
+			Ω(others[0].ID).Should(Equal(specs[2].ID))
+			Ω(others[1].ID).Should(Equal(specs[0].ID))
+		})
+	})
 })
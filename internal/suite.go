@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/onsi-experimental/ginkgo/v2/formatter"
@@ -35,18 +36,41 @@ type Suite struct {
 	interruptHandler  interrupt_handler.InterruptHandlerInterface
 	config            types.SuiteConfig
 
-	skipAll           bool
-	report            types.Report
-	currentSpecReport types.SpecReport
-	currentNode       Node
+	skipAll                 bool
+	report                  types.Report
+	currentSpecReport       types.SpecReport
+	currentNode             Node
+	currentOrderedContainer Node
+
+	// selectiveLock guards the state that is read when generating progress reports while a node is running
+	selectiveLock          *sync.Mutex
+	currentNodeStartTime   time.Time
+	currentNodeGoroutineID uint64
+	currentStepCursor      ProgressStepCursor
+	activeSteps            []activeStep
 
 	client parallel_support.Client
+
+	// these support running specs concurrently with --parallel-mode=goroutines.  reportLock guards report, skipAll, workers, abandonedWorker, and goroutineAttributions
+	reportLock            *sync.Mutex
+	workers               []*Suite
+	abandonedWorker       *Suite
+	goroutineAttributions map[uint64]goroutineAttribution
+	parent                *Suite
+}
+
+// activeStep tracks a By step that has not yet ended.  index refers to the step in currentSpecReport.Steps
+type activeStep struct {
+	index       int
+	hasCallback bool
 }
 
 func NewSuite() *Suite {
 	return &Suite{
-		tree:  &TreeNode{},
-		phase: PhaseBuildTopLevel,
+		tree:          &TreeNode{},
+		phase:         PhaseBuildTopLevel,
+		selectiveLock: &sync.Mutex{},
+		reportLock:    &sync.Mutex{},
 	}
 }
 
@@ -63,13 +87,14 @@ func (suite *Suite) BuildTree() error {
 	return nil
 }
 
-func (suite *Suite) Run(description string, suitePath string, failer *Failer, reporter reporters.Reporter, writer WriterInterface, outputInterceptor OutputInterceptor, interruptHandler interrupt_handler.InterruptHandlerInterface, client parallel_support.Client, suiteConfig types.SuiteConfig) (bool, bool) {
+func (suite *Suite) Run(description string, suitePath string, failer *Failer, reporter reporters.Reporter, writer WriterInterface, outputInterceptor OutputInterceptor, interruptHandler interrupt_handler.InterruptHandlerInterface, client parallel_support.Client, progressSignalRegistrar ProgressSignalRegistrar, suiteConfig types.SuiteConfig) (bool, bool) {
 	if suite.phase != PhaseBuildTree {
 		panic("cannot run before building the tree = call suite.BuildTree() first")
 	}
 	ApplyNestedFocusPolicyToTree(suite.tree)
 	specs := GenerateSpecsFromTreeRoot(suite.tree)
 	specs, hasProgrammaticFocus := ApplyFocusToSpecs(specs, description, suiteConfig)
+	specs = ApplyShardToSpecs(specs, suiteConfig)
 
 	suite.phase = PhaseRun
 	suite.client = client
@@ -80,6 +105,13 @@ func (suite *Suite) Run(description string, suitePath string, failer *Failer, re
 	suite.interruptHandler = interruptHandler
 	suite.config = suiteConfig
 
+	cancelProgressHandler := progressSignalRegistrar(suite.handleProgressSignal)
+	defer cancelProgressHandler()
+	if suite.isRunningInParallel() && suite.client != nil {
+		stopPolling := suite.pollForProgressReportRequests()
+		defer stopPolling()
+	}
+
 	success := suite.runSpecs(description, suitePath, hasProgrammaticFocus, specs)
 
 	return success, hasProgrammaticFocus
@@ -92,11 +124,22 @@ func (suite *Suite) Run(description string, suitePath string, failer *Failer, re
 */
 
 func (suite *Suite) PushNode(node Node) error {
-	if node.NodeType.Is(types.NodeTypeCleanupInvalid | types.NodeTypeCleanupAfterEach | types.NodeTypeCleanupAfterAll | types.NodeTypeCleanupAfterSuite) {
+	isCleanupNode := node.NodeType.Is(types.NodeTypeCleanupInvalid | types.NodeTypeCleanupAfterEach | types.NodeTypeCleanupAfterAll | types.NodeTypeCleanupAfterSuite)
+	if worker, ok := suite.workerForCurrentGoroutine(); !ok {
+		action := node.NodeType.String()
+		if isCleanupNode {
+			action = "DeferCleanup"
+		}
+		return types.GinkgoErrors.UnattributableGoroutineInGoroutineParallelMode(action, node.CodeLocation)
+	} else if worker != nil {
+		return worker.PushNode(node)
+	}
+
+	if isCleanupNode {
 		return suite.pushCleanupNode(node)
 	}
 
-	if node.NodeType.Is(types.NodeTypeBeforeSuite | types.NodeTypeAfterSuite | types.NodeTypeSynchronizedBeforeSuite | types.NodeTypeSynchronizedAfterSuite | types.NodeTypeReportAfterSuite) {
+	if node.NodeType.Is(types.NodeTypeBeforeSuite | types.NodeTypeAfterSuite | types.NodeTypeSynchronizedBeforeSuite | types.NodeTypeSynchronizedAfterSuite | types.NodeTypeReportBeforeSuite | types.NodeTypeReportAfterSuite) {
 		return suite.pushSuiteNode(node)
 	}
 
@@ -139,7 +182,7 @@ func (suite *Suite) PushNode(node Node) error {
 						err = types.GinkgoErrors.CaughtPanicDuringABuildPhase(e, node.CodeLocation)
 					}
 				}()
-				node.Body()
+				node.Body(nil)
 				return err
 			}()
 			suite.tree = parentTree
@@ -189,7 +232,12 @@ func (suite *Suite) pushCleanupNode(node Node) error {
 		node.NodeType = types.NodeTypeCleanupAfterSuite
 	case types.NodeTypeBeforeAll, types.NodeTypeAfterAll:
 		node.NodeType = types.NodeTypeCleanupAfterAll
-	case types.NodeTypeReportBeforeEach, types.NodeTypeReportAfterEach, types.NodeTypeReportAfterSuite:
+	case types.NodeTypeBeforeEach, types.NodeTypeJustBeforeEach, types.NodeTypeAfterEach, types.NodeTypeJustAfterEach:
+		node.NodeType = types.NodeTypeCleanupAfterEach
+		if suite.runsOncePerOrderedContainer(suite.currentNode) {
+			node.NodeType = types.NodeTypeCleanupAfterAll
+		}
+	case types.NodeTypeReportBeforeEach, types.NodeTypeReportAfterEach, types.NodeTypeReportBeforeSuite, types.NodeTypeReportAfterSuite:
 		return types.GinkgoErrors.PushingCleanupInReportingNode(node.CodeLocation, suite.currentNode.NodeType)
 	case types.NodeTypeCleanupInvalid, types.NodeTypeCleanupAfterEach, types.NodeTypeCleanupAfterAll, types.NodeTypeCleanupAfterSuite:
 		return types.GinkgoErrors.PushingCleanupInCleanupNode(node.CodeLocation)
@@ -208,6 +256,13 @@ func (suite *Suite) pushCleanupNode(node Node) error {
   Spec Running methods - used during PhaseRun
 */
 func (suite *Suite) CurrentSpecReport() types.SpecReport {
+	if worker, ok := suite.workerForCurrentGoroutine(); !ok {
+		suite.recordGoroutineError(types.GinkgoErrors.UnattributableGoroutineInGoroutineParallelMode("CurrentSpecReport", types.NewCodeLocation(2)))
+		return types.SpecReport{}
+	} else if worker != nil {
+		return worker.CurrentSpecReport()
+	}
+
 	report := suite.currentSpecReport
 	if suite.writer != nil {
 		report.CapturedGinkgoWriterOutput = string(suite.writer.Bytes())
@@ -216,6 +271,14 @@ func (suite *Suite) CurrentSpecReport() types.SpecReport {
 }
 
 func (suite *Suite) AddReportEntry(entry ReportEntry) error {
+	if worker, ok := suite.workerForCurrentGoroutine(); !ok {
+		err := types.GinkgoErrors.UnattributableGoroutineInGoroutineParallelMode("AddReportEntry", entry.Location)
+		suite.recordGoroutineError(err)
+		return err
+	} else if worker != nil {
+		return worker.AddReportEntry(entry)
+	}
+
 	if suite.phase != PhaseRun {
 		return types.GinkgoErrors.AddReportEntryNotDuringRunPhase(entry.Location)
 	}
@@ -223,6 +286,168 @@ func (suite *Suite) AddReportEntry(entry ReportEntry) error {
 	return nil
 }
 
+// BeginStep records the start of a By step in the current spec's step timeline and returns the step's index.
+// Steps with callbacks must be ended by passing the returned index to EndStep.  Steps without callbacks end when the next
+// step at the same nesting level begins or when the running node ends.
+func (suite *Suite) BeginStep(step types.SpecStep, hasCallback bool) int {
+	if worker, ok := suite.workerForCurrentGoroutine(); !ok {
+		suite.recordGoroutineError(types.GinkgoErrors.UnattributableGoroutineInGoroutineParallelMode("By", step.CodeLocation))
+		return -1
+	} else if worker != nil {
+		return worker.BeginStep(step, hasCallback)
+	}
+	// steps emitted by an abandoned node would otherwise land in the timeline of whichever spec is running now
+	if suite.failer.IsQuarantined() {
+		return -1
+	}
+
+	suite.selectiveLock.Lock()
+	defer suite.selectiveLock.Unlock()
+
+	suite.currentStepCursor = ProgressStepCursor{
+		Text:         step.Text,
+		CodeLocation: step.CodeLocation,
+		StartTime:    step.StartTime,
+	}
+	for len(suite.activeSteps) > 0 && !suite.activeSteps[len(suite.activeSteps)-1].hasCallback {
+		suite.endInnermostStep(step.StartTime)
+	}
+	step.NestingLevel = len(suite.activeSteps)
+	suite.currentSpecReport.Steps = append(suite.currentSpecReport.Steps, step)
+	index := len(suite.currentSpecReport.Steps) - 1
+	suite.activeSteps = append(suite.activeSteps, activeStep{index: index, hasCallback: hasCallback})
+	return index
+}
+
+// EndStep ends the step at index, along with any steps nested within it
+func (suite *Suite) EndStep(index int) {
+	if worker, ok := suite.workerForCurrentGoroutine(); !ok || index < 0 {
+		return
+	} else if worker != nil {
+		worker.EndStep(index)
+		return
+	}
+	if suite.failer.IsQuarantined() {
+		return
+	}
+
+	suite.selectiveLock.Lock()
+	defer suite.selectiveLock.Unlock()
+
+	now := time.Now()
+	for len(suite.activeSteps) > 0 && suite.activeSteps[len(suite.activeSteps)-1].index >= index {
+		suite.endInnermostStep(now)
+	}
+}
+
+// endActiveSteps ends all active steps when a node ends and returns the innermost step that was still active, if any
+func (suite *Suite) endActiveSteps() (types.SpecStep, bool) {
+	suite.selectiveLock.Lock()
+	defer suite.selectiveLock.Unlock()
+
+	if len(suite.activeSteps) == 0 {
+		return types.SpecStep{}, false
+	}
+	innermostStep := suite.currentSpecReport.Steps[suite.activeSteps[len(suite.activeSteps)-1].index]
+	now := time.Now()
+	for len(suite.activeSteps) > 0 {
+		suite.endInnermostStep(now)
+	}
+	return innermostStep, true
+}
+
+// endInnermostStep must be called with selectiveLock held
+func (suite *Suite) endInnermostStep(endTime time.Time) {
+	innermost := suite.activeSteps[len(suite.activeSteps)-1]
+	suite.activeSteps = suite.activeSteps[:len(suite.activeSteps)-1]
+	if innermost.index < len(suite.currentSpecReport.Steps) {
+		step := &suite.currentSpecReport.Steps[innermost.index]
+		step.Duration = endTime.Sub(step.StartTime)
+	}
+}
+
+func (suite *Suite) generateProgressReport() types.ProgressReport {
+	suite.selectiveLock.Lock()
+	defer suite.selectiveLock.Unlock()
+
+	var gwOutput string
+	if suite.writer != nil && !suite.currentNode.IsZero() {
+		gwOutput = string(suite.writer.Bytes())
+	}
+	report := NewProgressReport(suite.isRunningInParallel(), suite.currentSpecReport, suite.currentNode, suite.currentNodeStartTime, suite.currentStepCursor, gwOutput, suite.currentNodeGoroutineID)
+	report.ParallelProcess = suite.config.ParallelProcess
+	return report
+}
+
+func (suite *Suite) emitProgressReport() {
+	suite.reportLock.Lock()
+	workers := suite.workers
+	suite.reportLock.Unlock()
+	if len(workers) > 0 {
+		for _, worker := range workers {
+			worker.emitProgressReport()
+		}
+		return
+	}
+
+	report := suite.generateProgressReport()
+	if progressReporter, ok := suite.reporter.(reporters.ProgressReporter); ok {
+		progressReporter.EmitProgressReport(report)
+	}
+	if suite.isRunningInParallel() {
+		suite.client.PostEmitProgressReport(report)
+	}
+}
+
+func (suite *Suite) handleProgressSignal() {
+	if suite.isRunningInParallel() && suite.client != nil {
+		// all processes - including this one - will pick this up and emit a progress report
+		suite.client.PostProgressReportRequest()
+		return
+	}
+	suite.emitProgressReport()
+}
+
+func (suite *Suite) pollForProgressReportRequests() func() {
+	stop := make(chan interface{})
+	go func() {
+		lastCount, _ := suite.client.FetchProgressReportRequestCount()
+		ticker := time.NewTicker(PROGRESS_REPORT_REQUEST_POLLING_INTERVAL)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				count, err := suite.client.FetchProgressReportRequestCount()
+				if err == nil && count > lastCount {
+					lastCount = count
+					suite.emitProgressReport()
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
+
+func (suite *Suite) reportNodeWillRun(event types.NodeEvent) {
+	if nodeReporter, ok := suite.reporter.(reporters.NodeReporter); ok {
+		nodeReporter.NodeWillRun(suite.currentSpecReport, event)
+	}
+	if suite.isRunningInParallel() {
+		suite.client.PostNodeWillRun(suite.currentSpecReport, event)
+	}
+}
+
+func (suite *Suite) reportNodeDidRun(event types.NodeEvent) {
+	if nodeReporter, ok := suite.reporter.(reporters.NodeReporter); ok {
+		nodeReporter.NodeDidRun(suite.currentSpecReport, event)
+	}
+	if suite.isRunningInParallel() {
+		suite.client.PostNodeDidRun(suite.currentSpecReport, event)
+	}
+}
+
 func (suite *Suite) isRunningInParallel() bool {
 	return suite.config.ParallelTotal > 1
 }
@@ -232,11 +457,23 @@ func (suite *Suite) processCurrentSpecReport() {
 	if suite.isRunningInParallel() {
 		suite.client.PostDidRun(suite.currentSpecReport)
 	}
-	suite.report.SpecReports = append(suite.report.SpecReports, suite.currentSpecReport)
+	suite.recordSpecReport(suite.currentSpecReport)
+}
+
+// recordSpecReport adds a completed spec report to the suite's report.  Goroutine workers record their spec reports on the suite that spawned them.
+func (suite *Suite) recordSpecReport(specReport types.SpecReport) {
+	if suite.parent != nil {
+		suite.parent.recordSpecReport(specReport)
+		return
+	}
+
+	suite.reportLock.Lock()
+	defer suite.reportLock.Unlock()
+	suite.report.SpecReports = append(suite.report.SpecReports, specReport)
 
-	if suite.currentSpecReport.State.Is(types.SpecStateFailureStates) {
+	if specReport.State.Is(types.SpecStateFailureStates) {
 		suite.report.SuiteSucceeded = false
-		if suite.config.FailFast || suite.currentSpecReport.State.Is(types.SpecStateAborted) {
+		if suite.config.FailFast || specReport.State.Is(types.SpecStateAborted) {
 			suite.skipAll = true
 			if suite.isRunningInParallel() {
 				suite.client.PostAbort()
@@ -245,6 +482,16 @@ func (suite *Suite) processCurrentSpecReport() {
 	}
 }
 
+func (suite *Suite) shouldSkipAll() bool {
+	if suite.parent != nil {
+		return suite.parent.shouldSkipAll()
+	}
+
+	suite.reportLock.Lock()
+	defer suite.reportLock.Unlock()
+	return suite.skipAll
+}
+
 func (suite *Suite) runSpecs(description string, suitePath string, hasProgrammaticFocus bool, specs Specs) bool {
 	numSpecsThatWillBeRun := specs.CountWithoutSkip()
 
@@ -266,13 +513,25 @@ func (suite *Suite) runSpecs(description string, suitePath string, hasProgrammat
 	}
 
 	suite.report.SuiteSucceeded = true
+	if suite.config.ParallelProcess == 1 {
+		suite.runReportBeforeSuite(specs)
+	}
 	suite.runBeforeSuite(numSpecsThatWillBeRun)
 
 	if suite.report.SuiteSucceeded {
 		groupedSpecIndices, serialGroupedSpecIndices := OrderSpecs(specs, suite.config)
+		if numGoroutines := suite.config.ComputedParallelGoroutines(); numGoroutines > 1 {
+			// the parallelizable groups run concurrently across goroutines, then the serial groups run in series on this goroutine
+			suite.runGroupsConcurrently(specs, groupedSpecIndices, numGoroutines)
+			groupedSpecIndices, serialGroupedSpecIndices = serialGroupedSpecIndices, GroupedSpecIndices{}
+		}
 		nextIndex := MakeIncrementingIndexCounter()
 		if suite.isRunningInParallel() {
-			nextIndex = suite.client.FetchNextCounter
+			nextIndex = func() (int, error) { return suite.client.FetchNextCounter(suite.config.ParallelProcess) }
+			// the server uses the groups to hand out the slowest groups first (when it knows their run times) and to honor Exclusive keys
+			if err := suite.client.PostSpecGroups(specGroupsForScheduling(specs, groupedSpecIndices)); err != nil {
+				nextIndex = func() (int, error) { return 0, err }
+			}
 		}
 
 		for {
@@ -369,6 +628,40 @@ func (suite *Suite) runAfterSuiteCleanup(numSpecsThatWillBeRun int) {
 	}
 }
 
+/*
+runReportBeforeSuite hands ReportBeforeSuite nodes a preliminary report that includes a SpecReport for every spec in the suite.
+Specs that will be skipped or are pending have their State set accordingly - all other SpecReports are in the SpecStateInvalid state as they have not run yet.
+*/
+func (suite *Suite) runReportBeforeSuite(specs Specs) {
+	reportBeforeSuiteNodes := suite.suiteNodes.WithType(types.NodeTypeReportBeforeSuite)
+	if len(reportBeforeSuiteNodes) == 0 {
+		return
+	}
+
+	report := suite.report
+	report.SpecReports = types.SpecReports{}
+	for _, spec := range specs {
+		specReport := suite.initialSpecReport(spec)
+		if spec.Nodes.HasNodeMarkedPending() {
+			specReport.State = types.SpecStatePending
+		} else if spec.Skip {
+			specReport.State = types.SpecStateSkipped
+		}
+		report.SpecReports = append(report.SpecReports, specReport)
+	}
+
+	for _, node := range reportBeforeSuiteNodes {
+		suite.currentSpecReport = types.SpecReport{
+			LeafNodeType:     node.NodeType,
+			LeafNodeLocation: node.CodeLocation,
+			ParallelProcess:  suite.config.ParallelProcess,
+		}
+		suite.reporter.WillRun(suite.currentSpecReport)
+		suite.runReportSuiteNode(node, report)
+		suite.processCurrentSpecReport()
+	}
+}
+
 func (suite *Suite) runReportAfterSuite() {
 	for _, node := range suite.suiteNodes.WithType(types.NodeTypeReportAfterSuite) {
 		suite.currentSpecReport = types.SpecReport{
@@ -378,15 +671,44 @@ func (suite *Suite) runReportAfterSuite() {
 			ParallelProcess:  suite.config.ParallelProcess,
 		}
 		suite.reporter.WillRun(suite.currentSpecReport)
-		suite.runReportAfterSuiteNode(node, suite.report)
+		suite.runReportSuiteNode(node, suite.report)
 		suite.processCurrentSpecReport()
 	}
 }
 
+/*
+runsOncePerOrderedContainer returns true if the node should only run once for the Ordered container currently being run.
+This is true of BeforeAll and AfterAll nodes and of setup nodes decorated with OncePerOrdered that wrap the Ordered container.
+OncePerOrdered setup nodes inside the Ordered container, or that are not running against an Ordered container, run around each spec as usual.
+*/
+func (suite *Suite) runsOncePerOrderedContainer(node Node) bool {
+	if node.NodeType.Is(types.NodeTypeBeforeAll | types.NodeTypeAfterAll) {
+		return true
+	}
+	if !node.MarkedOncePerOrdered || suite.currentOrderedContainer.IsZero() {
+		return false
+	}
+	return node.NestingLevel <= suite.currentOrderedContainer.NestingLevel
+}
+
 func (suite *Suite) runGroup(specs Specs) {
 	nodeState := map[uint]types.SpecState{}
 	groupSucceeded := true
 
+	//specs are grouped by their outermost Ordered container, so all the specs in the group share it
+	suite.currentOrderedContainer = specs[0].Nodes.FirstNodeMarkedOrdered()
+	defer func() {
+		suite.currentOrderedContainer = Node{}
+	}()
+	isRunOnceSetupNode := func(n Node) bool {
+		return n.NodeType.Is(types.NodeTypeBeforeAll|types.NodeTypeBeforeEach|types.NodeTypeJustBeforeEach) && suite.runsOncePerOrderedContainer(n)
+	}
+	isRunOnceTeardownNode := func(n Node) bool {
+		return n.NodeType.Is(types.NodeTypeAfterAll|types.NodeTypeAfterEach|types.NodeTypeJustAfterEach) && suite.runsOncePerOrderedContainer(n)
+	}
+	//with ContinueOnFailure, specs in the ordered container keep running after a failure - unless the failure occurred in a setup node that runs once for the container
+	continueOnFailure := suite.currentOrderedContainer.MarkedContinueOnFailure
+
 	indexOfLastSpecContainingNodeID := func(id uint) int {
 		lastIdx := -1
 		for idx := range specs {
@@ -398,25 +720,14 @@ func (suite *Suite) runGroup(specs Specs) {
 	}
 
 	for i, spec := range specs {
-		suite.currentSpecReport = types.SpecReport{
-			ContainerHierarchyTexts:     spec.Nodes.WithType(types.NodeTypeContainer).Texts(),
-			ContainerHierarchyLocations: spec.Nodes.WithType(types.NodeTypeContainer).CodeLocations(),
-			ContainerHierarchyLabels:    spec.Nodes.WithType(types.NodeTypeContainer).Labels(),
-			LeafNodeLocation:            spec.FirstNodeWithType(types.NodeTypeIt).CodeLocation,
-			LeafNodeType:                types.NodeTypeIt,
-			LeafNodeText:                spec.FirstNodeWithType(types.NodeTypeIt).Text,
-			LeafNodeLabels:              []string(spec.FirstNodeWithType(types.NodeTypeIt).Labels),
-			ParallelProcess:             suite.config.ParallelProcess,
-			IsSerial:                    spec.Nodes.HasNodeMarkedSerial(),
-			IsInOrderedContainer:        !spec.Nodes.FirstNodeMarkedOrdered().IsZero(),
-		}
+		suite.currentSpecReport = suite.initialSpecReport(spec)
 
 		skip := spec.Skip
 		if spec.Nodes.HasNodeMarkedPending() {
 			skip = true
 			suite.currentSpecReport.State = types.SpecStatePending
 		} else {
-			if suite.interruptHandler.Status().Interrupted || suite.skipAll {
+			if suite.interruptHandler.Status().Interrupted || suite.shouldSkipAll() {
 				skip = true
 			}
 			if !groupSucceeded {
@@ -424,11 +735,11 @@ func (suite *Suite) runGroup(specs Specs) {
 				suite.currentSpecReport.Failure = suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt),
 					"Spec skipped because an earlier spec in an ordered container failed")
 			}
-			for _, node := range spec.Nodes.WithType(types.NodeTypeBeforeAll) {
+			for _, node := range spec.Nodes.Filter(isRunOnceSetupNode) {
 				if nodeState[node.ID] == types.SpecStateSkipped {
 					skip = true
 					suite.currentSpecReport.Failure = suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt),
-						"Spec skipped because Skip() was called in BeforeAll")
+						fmt.Sprintf("Spec skipped because Skip() was called in %s", node.NodeType))
 					break
 				}
 			}
@@ -451,33 +762,57 @@ func (suite *Suite) runGroup(specs Specs) {
 		}
 
 		suite.currentSpecReport.StartTime = time.Now()
-		maxAttempts := max(1, spec.FlakeAttempts())
-		if suite.config.FlakeAttempts > 0 {
+		//the command-line flags take precedence over the decorators.  FlakeAttempts retries failing specs, MustPassRepeatedly repeats passing specs.
+		maxAttempts, mustPassRepeatedly := 1, false
+		switch {
+		case suite.config.FlakeAttempts > 0:
 			maxAttempts = suite.config.FlakeAttempts
+		case suite.config.MustPassRepeatedly > 0:
+			maxAttempts, mustPassRepeatedly = suite.config.MustPassRepeatedly, true
+		case spec.FlakeAttempts() > 0:
+			maxAttempts = spec.FlakeAttempts()
+		case spec.MustPassRepeatedly() > 0:
+			maxAttempts, mustPassRepeatedly = spec.MustPassRepeatedly(), true
 		}
+		if mustPassRepeatedly {
+			suite.currentSpecReport.MaxMustPassRepeatedly = maxAttempts
+		}
+
+		failedInRunOnceSetupNode := false
 
 		for attempt := 0; !skip && (attempt < maxAttempts); attempt++ {
 			suite.currentSpecReport.NumAttempts = attempt + 1
 			suite.writer.Truncate()
 			suite.outputInterceptor.StartInterceptingOutput()
 			if attempt > 0 {
-				fmt.Fprintf(suite.writer, "\nGinkgo: Attempt #%d Failed.  Retrying...\n", attempt)
+				if mustPassRepeatedly {
+					fmt.Fprintf(suite.writer, "\nGinkgo: Attempt #%d Passed.  Repeating...\n", attempt)
+				} else {
+					fmt.Fprintf(suite.writer, "\nGinkgo: Attempt #%d Failed.  Retrying...\n", attempt)
+				}
 			}
 			isFinalAttempt := (attempt == maxAttempts-1)
 
+			var specDeadline time.Time
+			if spec.SpecTimeout() > 0 {
+				specDeadline = time.Now().Add(spec.SpecTimeout())
+			}
+
 			interruptStatus := suite.interruptHandler.Status()
 			deepestNestingLevelAttained := -1
-			var nodes = spec.Nodes.WithType(types.NodeTypeBeforeAll).Filter(func(n Node) bool {
-				return nodeState[n.ID] != types.SpecStatePassed
-			})
-			nodes = nodes.CopyAppend(spec.Nodes.WithType(types.NodeTypeBeforeEach)...).SortedByAscendingNestingLevel()
+			//setup nodes that run once per ordered container only run if they haven't already passed
+			shouldRunBeforeNode := func(n Node) bool {
+				return !isRunOnceSetupNode(n) || nodeState[n.ID] != types.SpecStatePassed
+			}
+			var nodes = spec.Nodes.WithType(types.NodeTypeBeforeAll).CopyAppend(spec.Nodes.WithType(types.NodeTypeBeforeEach)...).SortedByAscendingNestingLevel()
 			nodes = nodes.CopyAppend(spec.Nodes.WithType(types.NodeTypeJustBeforeEach).SortedByAscendingNestingLevel()...)
+			nodes = nodes.Filter(shouldRunBeforeNode)
 			nodes = nodes.CopyAppend(spec.Nodes.WithType(types.NodeTypeIt)...)
 
 			var terminatingNode Node
 			for j := range nodes {
 				deepestNestingLevelAttained = max(deepestNestingLevelAttained, nodes[j].NestingLevel)
-				suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(nodes[j], interruptStatus.Channel, spec.Nodes.BestTextFor(nodes[j]))
+				suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(nodes[j], specDeadline, interruptStatus.Channel, spec.Nodes.BestTextFor(nodes[j]))
 				suite.currentSpecReport.RunTime = time.Since(suite.currentSpecReport.StartTime)
 				nodeState[nodes[j].ID] = suite.currentSpecReport.State
 				if suite.currentSpecReport.State != types.SpecStatePassed {
@@ -485,12 +820,18 @@ func (suite *Suite) runGroup(specs Specs) {
 					break
 				}
 			}
+			failedInRunOnceSetupNode = isRunOnceSetupNode(terminatingNode)
 
 			afterAllNodesThatRan := map[uint]bool{}
 			// pull out some shared code so we aren't repeating ourselves down below. this just runs after and cleanup nodes
 			runAfterAndCleanupNodes := func(nodes Nodes) {
 				for j := range nodes {
-					state, failure := suite.runNode(nodes[j], suite.interruptHandler.Status().Channel, spec.Nodes.BestTextFor(nodes[j]))
+					deadline := specDeadline
+					if suite.currentSpecReport.State.Is(types.SpecStateTimedout) {
+						//the spec has already timed out - give the after nodes a chance to clean up without the spec deadline
+						deadline = time.Time{}
+					}
+					state, failure := suite.runNode(nodes[j], deadline, suite.interruptHandler.Status().Channel, spec.Nodes.BestTextFor(nodes[j]))
 					suite.currentSpecReport.RunTime = time.Since(suite.currentSpecReport.StartTime)
 					nodeState[nodes[j].ID] = state
 					if suite.currentSpecReport.State == types.SpecStatePassed || state == types.SpecStateAborted {
@@ -500,7 +841,7 @@ func (suite *Suite) runGroup(specs Specs) {
 							terminatingNode = nodes[j]
 						}
 					}
-					if nodes[j].NodeType.Is(types.NodeTypeAfterAll) {
+					if isRunOnceTeardownNode(nodes[j]) {
 						afterAllNodesThatRan[nodes[j].ID] = true
 					}
 				}
@@ -509,11 +850,11 @@ func (suite *Suite) runGroup(specs Specs) {
 			// pull out a helper that captures the logic of whether or not we should run a given After node.
 			// there is complexity here stemming from the fact that we allow nested ordered contexts and flakey retries
 			shouldRunAfterNode := func(n Node) bool {
-				if n.NodeType.Is(types.NodeTypeAfterEach | types.NodeTypeJustAfterEach) {
+				if n.NodeType.Is(types.NodeTypeAfterEach|types.NodeTypeJustAfterEach) && !isRunOnceTeardownNode(n) {
 					return true
 				}
 				var id uint
-				if n.NodeType.Is(types.NodeTypeAfterAll) {
+				if isRunOnceTeardownNode(n) {
 					id = n.ID
 					if afterAllNodesThatRan[id] { //we've already run on this attempt. don't run again.
 						return false
@@ -526,28 +867,34 @@ func (suite *Suite) runGroup(specs Specs) {
 
 				switch suite.currentSpecReport.State {
 				case types.SpecStatePassed: //we've passed so far...
+					if mustPassRepeatedly && !isFinalAttempt {
+						return false //...but we're going to repeat this spec, so we'll need any setup the AfterNode would tear down
+					}
 					return isLastSpecWithNode //... and we're the last spec with this AfterNode, so we should run it
 				case types.SpecStateSkipped: //the spec was skipped by the user...
 					if isLastSpecWithNode {
 						return true //...we're the last spec, so we should run the AfterNode
 					}
-					if terminatingNode.NodeType.Is(types.NodeTypeBeforeAll) && terminatingNode.NestingLevel == n.NestingLevel {
+					if isRunOnceSetupNode(terminatingNode) && terminatingNode.NestingLevel == n.NestingLevel {
 						return true //...or, a BeforeAll was skipped and it's at our nesting level, so our subgroup is going to skip
 					}
-				case types.SpecStateFailed, types.SpecStatePanicked: // the spec has failed...
-					if isFinalAttempt {
-						return true //...if this was the last attempt then we're the last spec to run and so the AfterNode should run
+				case types.SpecStateFailed, types.SpecStatePanicked, types.SpecStateTimedout: // the spec has failed...
+					if (isFinalAttempt || mustPassRepeatedly) && continueOnFailure && !failedInRunOnceSetupNode {
+						return isLastSpecWithNode //...but subsequent specs will continue to run, so we only run the AfterNode if we're the last spec with it
 					}
-					if terminatingNode.NodeType.Is(types.NodeTypeBeforeAll) {
+					if isFinalAttempt || mustPassRepeatedly {
+						return true //...if this was the last attempt (a failure always ends a repeated spec) then we're the last spec to run and so the AfterNode should run
+					}
+					if isRunOnceSetupNode(terminatingNode) {
 						//...we'll be rerunning a BeforeAll so we should cleanup after it if...
-						if n.NodeType.Is(types.NodeTypeAfterAll) && terminatingNode.NestingLevel == n.NestingLevel {
+						if isRunOnceTeardownNode(n) && terminatingNode.NestingLevel == n.NestingLevel {
 							return true //we're at the same nesting level
 						}
 						if n.NodeType.Is(types.NodeTypeCleanupAfterAll) && terminatingNode.ID == n.NodeIDWhereCleanupWasGenerated {
 							return true //we're a DeferCleanup generated by it
 						}
 					}
-					if terminatingNode.NodeType.Is(types.NodeTypeAfterAll) {
+					if isRunOnceTeardownNode(terminatingNode) {
 						//...we'll be rerunning an AfterAll so we should cleanup after it if...
 						if n.NodeType.Is(types.NodeTypeCleanupAfterAll) && terminatingNode.ID == n.NodeIDWhereCleanupWasGenerated {
 							return true //we're a DeferCleanup generated by it
@@ -566,8 +913,11 @@ func (suite *Suite) runGroup(specs Specs) {
 			afterNodes = afterNodes.Filter(shouldRunAfterNode)
 			runAfterAndCleanupNodes(afterNodes)
 
-			// second-pass perhaps we didn't run the AfterAlls but a state change due to an AfterEach now requires us to run the AfterAlls:
-			afterNodes = spec.Nodes.WithType(types.NodeTypeAfterAll).WithinNestingLevel(deepestNestingLevelAttained).Filter(shouldRunAfterNode)
+			// second-pass perhaps we didn't run the AfterAlls (and OncePerOrdered AfterEaches) but a state change due to an AfterEach now requires us to run them:
+			runOnceAfterNodes := spec.Nodes.WithType(types.NodeTypeJustAfterEach).Filter(isRunOnceTeardownNode).SortedByDescendingNestingLevel()
+			runOnceAfterNodes = runOnceAfterNodes.CopyAppend(spec.Nodes.WithType(types.NodeTypeAfterEach|types.NodeTypeAfterAll).Filter(isRunOnceTeardownNode).SortedByDescendingNestingLevel()...)
+			runOnceAfterNodes = runOnceAfterNodes.WithinNestingLevel(deepestNestingLevelAttained)
+			afterNodes = runOnceAfterNodes.Filter(shouldRunAfterNode)
 			runAfterAndCleanupNodes(afterNodes)
 
 			// now we run any DeferCleanups
@@ -576,7 +926,7 @@ func (suite *Suite) runGroup(specs Specs) {
 			runAfterAndCleanupNodes(afterNodes)
 
 			// third-pass, perhaps a DeferCleanup failed and now we need to run the AfterAlls.
-			afterNodes = spec.Nodes.WithType(types.NodeTypeAfterAll).WithinNestingLevel(deepestNestingLevelAttained).Filter(shouldRunAfterNode)
+			afterNodes = runOnceAfterNodes.Filter(shouldRunAfterNode)
 			runAfterAndCleanupNodes(afterNodes)
 
 			// and finally - running AfterAlls may have generated some new DeferCleanup nodes, let's run them to finish up
@@ -588,7 +938,11 @@ func (suite *Suite) runGroup(specs Specs) {
 			suite.currentSpecReport.CapturedGinkgoWriterOutput += string(suite.writer.Bytes())
 			suite.currentSpecReport.CapturedStdOutErr += suite.outputInterceptor.StopInterceptingAndReturnOutput()
 
-			if suite.currentSpecReport.State.Is(types.SpecStatePassed | types.SpecStateSkipped | types.SpecStateAborted | types.SpecStateInterrupted) {
+			if mustPassRepeatedly {
+				if !suite.currentSpecReport.State.Is(types.SpecStatePassed) {
+					break
+				}
+			} else if suite.currentSpecReport.State.Is(types.SpecStatePassed | types.SpecStateSkipped | types.SpecStateAborted | types.SpecStateInterrupted) {
 				break
 			}
 		}
@@ -596,7 +950,7 @@ func (suite *Suite) runGroup(specs Specs) {
 		//send the spec report to any attached ReportAfterEach blocks - this will update suite.currentSpecReport if failures occur in these blocks
 		suite.reportEach(spec, types.NodeTypeReportAfterEach)
 		suite.processCurrentSpecReport()
-		if suite.currentSpecReport.State.Is(types.SpecStateFailureStates) {
+		if suite.currentSpecReport.State.Is(types.SpecStateFailureStates) && (!continueOnFailure || failedInRunOnceSetupNode) {
 			groupSucceeded = false
 		}
 		suite.currentSpecReport = types.SpecReport{}
@@ -623,7 +977,7 @@ func (suite *Suite) reportEach(spec Spec, nodeType types.NodeType) {
 		suite.writer.Truncate()
 		suite.outputInterceptor.StartInterceptingOutput()
 		report := suite.currentSpecReport
-		nodes[i].Body = func() {
+		nodes[i].Body = func(SpecContext) {
 			nodes[i].ReportEachBody(report)
 		}
 		suite.interruptHandler.SetInterruptPlaceholderMessage(formatter.Fiw(0, formatter.COLS,
@@ -631,7 +985,7 @@ func (suite *Suite) reportEach(spec Spec, nodeType types.NodeType) {
 			nodeType, nodeType, nodeType,
 			nodes[i].CodeLocation,
 		))
-		state, failure := suite.runNode(nodes[i], nil, spec.Nodes.BestTextFor(nodes[i]))
+		state, failure := suite.runNode(nodes[i], time.Time{}, nil, spec.Nodes.BestTextFor(nodes[i]))
 		suite.interruptHandler.ClearInterruptPlaceholderMessage()
 		// If the spec is not in a failure state (i.e. it's Passed/Skipped/Pending) and the reporter has failed, override the state.
 		// Also, if the reporter is every aborted - always override the state to propagate the abort
@@ -657,13 +1011,13 @@ func (suite *Suite) runSuiteNode(node Node, interruptChannel chan interface{}) {
 	var err error
 	switch node.NodeType {
 	case types.NodeTypeBeforeSuite, types.NodeTypeAfterSuite:
-		suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, interruptChannel, "")
+		suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, time.Time{}, interruptChannel, "")
 	case types.NodeTypeCleanupAfterSuite:
 		if suite.config.ParallelTotal > 1 && suite.config.ParallelProcess == 1 {
 			err = suite.client.BlockUntilNonprimaryProcsHaveFinished()
 		}
 		if err == nil {
-			suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, interruptChannel, "")
+			suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, time.Time{}, interruptChannel, "")
 		}
 	case types.NodeTypeSynchronizedBeforeSuite:
 		var data []byte
@@ -673,8 +1027,8 @@ func (suite *Suite) runSuiteNode(node Node, interruptChannel chan interface{}) {
 				suite.outputInterceptor.StopInterceptingAndReturnOutput()
 				suite.outputInterceptor.StartInterceptingOutputAndForwardTo(suite.client)
 			}
-			node.Body = func() { data = node.SynchronizedBeforeSuiteProc1Body() }
-			suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, interruptChannel, "")
+			node.Body = func(SpecContext) { data = node.SynchronizedBeforeSuiteProc1Body() }
+			suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, time.Time{}, interruptChannel, "")
 			if suite.config.ParallelTotal > 1 {
 				suite.currentSpecReport.CapturedStdOutErr += suite.outputInterceptor.StopInterceptingAndReturnOutput()
 				suite.outputInterceptor.StartInterceptingOutput()
@@ -691,19 +1045,19 @@ func (suite *Suite) runSuiteNode(node Node, interruptChannel chan interface{}) {
 			switch proc1State {
 			case types.SpecStatePassed:
 				runAllProcs = true
-			case types.SpecStateFailed, types.SpecStatePanicked:
+			case types.SpecStateFailed, types.SpecStatePanicked, types.SpecStateTimedout:
 				err = types.GinkgoErrors.SynchronizedBeforeSuiteFailedOnProc1()
 			case types.SpecStateInterrupted, types.SpecStateAborted, types.SpecStateSkipped:
 				suite.currentSpecReport.State = proc1State
 			}
 		}
 		if runAllProcs {
-			node.Body = func() { node.SynchronizedBeforeSuiteAllProcsBody(data) }
-			suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, interruptChannel, "")
+			node.Body = func(SpecContext) { node.SynchronizedBeforeSuiteAllProcsBody(data) }
+			suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, time.Time{}, interruptChannel, "")
 		}
 	case types.NodeTypeSynchronizedAfterSuite:
-		node.Body = node.SynchronizedAfterSuiteAllProcsBody
-		suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, interruptChannel, "")
+		node.Body = func(SpecContext) { node.SynchronizedAfterSuiteAllProcsBody() }
+		suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, time.Time{}, interruptChannel, "")
 		if suite.config.ParallelProcess == 1 {
 			if suite.config.ParallelTotal > 1 {
 				err = suite.client.BlockUntilNonprimaryProcsHaveFinished()
@@ -714,8 +1068,8 @@ func (suite *Suite) runSuiteNode(node Node, interruptChannel chan interface{}) {
 					suite.outputInterceptor.StartInterceptingOutputAndForwardTo(suite.client)
 				}
 
-				node.Body = node.SynchronizedAfterSuiteProc1Body
-				state, failure := suite.runNode(node, interruptChannel, "")
+				node.Body = func(SpecContext) { node.SynchronizedAfterSuiteProc1Body() }
+				state, failure := suite.runNode(node, time.Time{}, interruptChannel, "")
 				if suite.currentSpecReport.State.Is(types.SpecStatePassed) {
 					suite.currentSpecReport.State, suite.currentSpecReport.Failure = state, failure
 				}
@@ -735,8 +1089,27 @@ func (suite *Suite) runSuiteNode(node Node, interruptChannel chan interface{}) {
 	return
 }
 
-func (suite *Suite) runReportAfterSuiteNode(node Node, report types.Report) {
-	if suite.config.DryRun {
+func (suite *Suite) initialSpecReport(spec Spec) types.SpecReport {
+	return types.SpecReport{
+		ContainerHierarchyTexts:     spec.Nodes.WithType(types.NodeTypeContainer).Texts(),
+		ContainerHierarchyLocations: spec.Nodes.WithType(types.NodeTypeContainer).CodeLocations(),
+		ContainerHierarchyLabels:    spec.Nodes.WithType(types.NodeTypeContainer).Labels(),
+		LeafNodeLocation:            spec.FirstNodeWithType(types.NodeTypeIt).CodeLocation,
+		LeafNodeType:                types.NodeTypeIt,
+		LeafNodeText:                spec.FirstNodeWithType(types.NodeTypeIt).Text,
+		LeafNodeLabels:              []string(spec.FirstNodeWithType(types.NodeTypeIt).Labels),
+		SpecID:                      spec.ID,
+		ParallelProcess:             suite.config.ParallelProcess,
+		IsSerial:                    spec.Nodes.HasNodeMarkedSerial(),
+		IsFocused:                   spec.Nodes.HasNodeMarkedFocus(),
+		IsInOrderedContainer:        !spec.Nodes.FirstNodeMarkedOrdered().IsZero(),
+		SpecPriority:                spec.SpecPriority(),
+		ExclusiveKeys:               spec.ExclusiveKeys(),
+	}
+}
+
+func (suite *Suite) runReportSuiteNode(node Node, report types.Report) {
+	if suite.config.DryRun && !node.IsAutogeneratedReport {
 		suite.currentSpecReport.State = types.SpecStatePassed
 		return
 	}
@@ -745,7 +1118,7 @@ func (suite *Suite) runReportAfterSuiteNode(node Node, report types.Report) {
 	suite.outputInterceptor.StartInterceptingOutput()
 	suite.currentSpecReport.StartTime = time.Now()
 
-	if suite.config.ParallelTotal > 1 {
+	if node.NodeType.Is(types.NodeTypeReportAfterSuite) && suite.config.ParallelTotal > 1 {
 		aggregatedReport, err := suite.client.BlockUntilAggregatedNonprimaryProcsReport()
 		if err != nil {
 			suite.currentSpecReport.State, suite.currentSpecReport.Failure = types.SpecStateFailed, suite.failureForLeafNodeWithMessage(node, err.Error())
@@ -754,12 +1127,12 @@ func (suite *Suite) runReportAfterSuiteNode(node Node, report types.Report) {
 		report = report.Add(aggregatedReport)
 	}
 
-	node.Body = func() { node.ReportAfterSuiteBody(report) }
+	node.Body = func(SpecContext) { node.ReportSuiteBody(report) }
 	suite.interruptHandler.SetInterruptPlaceholderMessage(formatter.Fiw(0, formatter.COLS,
-		"{{yellow}}Ginkgo received an interrupt signal but is currently running a ReportAfterSuite node.  To avoid an invalid report the ReportAfterSuite node will not be interrupted.{{/}}\n\n{{bold}}The running ReportAfterSuite node is at:\n%s.{{/}}",
-		node.CodeLocation,
+		"{{yellow}}Ginkgo received an interrupt signal but is currently running a %s node.  To avoid an invalid report the %s node will not be interrupted.{{/}}\n\n{{bold}}The running %s node is at:\n%s.{{/}}",
+		node.NodeType, node.NodeType, node.NodeType, node.CodeLocation,
 	))
-	suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, nil, "")
+	suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, time.Time{}, nil, "")
 	suite.interruptHandler.ClearInterruptPlaceholderMessage()
 
 	suite.currentSpecReport.EndTime = time.Now()
@@ -770,14 +1143,44 @@ func (suite *Suite) runReportAfterSuiteNode(node Node, report types.Report) {
 	return
 }
 
-func (suite *Suite) runNode(node Node, interruptChannel chan interface{}, text string) (types.SpecState, types.Failure) {
+func (suite *Suite) runNode(node Node, specDeadline time.Time, interruptChannel chan interface{}, text string) (state types.SpecState, failure types.Failure) {
 	if node.NodeType.Is(types.NodeTypeCleanupAfterEach | types.NodeTypeCleanupAfterAll | types.NodeTypeCleanupAfterSuite) {
 		suite.cleanupNodes = suite.cleanupNodes.WithoutNode(node)
 	}
 
+	startTime := time.Now()
+	suite.selectiveLock.Lock()
 	suite.currentNode = node
+	suite.currentNodeStartTime = startTime
+	suite.currentNodeGoroutineID = 0
+	suite.selectiveLock.Unlock()
 	defer func() {
+		suite.selectiveLock.Lock()
 		suite.currentNode = Node{}
+		suite.currentNodeStartTime = time.Time{}
+		suite.currentNodeGoroutineID = 0
+		suite.selectiveLock.Unlock()
+	}()
+
+	event := types.NodeEvent{
+		ParallelProcess: suite.config.ParallelProcess,
+		NodeType:        node.NodeType,
+		NodeText:        node.Text,
+		NodeLocation:    node.CodeLocation,
+		StartTime:       startTime,
+	}
+	suite.reportNodeWillRun(event)
+	defer func() {
+		event.EndTime = time.Now()
+		event.RunTime = event.EndTime.Sub(event.StartTime)
+		event.State, event.Failure = state, failure
+		suite.reportNodeDidRun(event)
+	}()
+	defer func() {
+		innermostStep, hadActiveStep := suite.endActiveSteps()
+		if hadActiveStep && state.Is(types.SpecStateFailureStates) {
+			failure.FailureStepText, failure.FailureStepLocation = innermostStep.Text, innermostStep.CodeLocation
+		}
 	}()
 
 	if suite.config.EmitSpecProgress {
@@ -788,7 +1191,6 @@ func (suite *Suite) runNode(node Node, interruptChannel chan interface{}, text s
 		suite.writer.Write([]byte(s))
 	}
 
-	var failure types.Failure
 	failure.FailureNodeType, failure.FailureNodeLocation = node.NodeType, node.CodeLocation
 	if node.NodeType.Is(types.NodeTypeIt) || node.NodeType.Is(types.NodeTypesForSuiteLevelNodes) {
 		failure.FailureNodeContext = types.FailureNodeIsLeafNode
@@ -798,10 +1200,27 @@ func (suite *Suite) runNode(node Node, interruptChannel chan interface{}, text s
 		failure.FailureNodeContext, failure.FailureNodeContainerIndex = types.FailureNodeInContainer, node.NestingLevel-1
 	}
 
-	outcomeC := make(chan types.SpecState)
-	failureC := make(chan types.Failure)
+	deadline, isSpecTimeout := specDeadline, !specDeadline.IsZero()
+	if node.NodeTimeout > 0 {
+		nodeDeadline := time.Now().Add(node.NodeTimeout)
+		if deadline.IsZero() || nodeDeadline.Before(deadline) {
+			deadline, isSpecTimeout = nodeDeadline, false
+		}
+	}
+	sc := NewSpecContext(suite, deadline)
+	defer sc.cancel()
+
+	//these are buffered so that an abandoned node body can always run to completion without blocking
+	goroutineIDC := make(chan uint64, 1)
+	outcomeC := make(chan types.SpecState, 1)
+	failureC := make(chan types.Failure, 1)
 
 	go func() {
+		goroutineID := CurrentGoroutineID()
+		suite.selectiveLock.Lock()
+		suite.currentNodeGoroutineID = goroutineID
+		suite.selectiveLock.Unlock()
+		goroutineIDC <- goroutineID
 		finished := false
 		defer func() {
 			if e := recover(); e != nil || !finished {
@@ -813,22 +1232,83 @@ func (suite *Suite) runNode(node Node, interruptChannel chan interface{}, text s
 			failureC <- failureFromRun
 		}()
 
-		node.Body()
+		node.Body(sc)
 		finished = true
 	}()
+	goroutineID := <-goroutineIDC
+
+	pollProgressAfter := suite.config.PollProgressAfter
+	if node.PollProgressAfter > 0 {
+		pollProgressAfter = node.PollProgressAfter
+	}
+	var emitProgressNow <-chan time.Time
+	if pollProgressAfter > 0 {
+		progressPoller := time.NewTimer(pollProgressAfter)
+		defer progressPoller.Stop()
+		emitProgressNow = progressPoller.C
+	}
+
+	var repeatedInterruptChannel chan interface{}
+	for state == types.SpecStateInvalid {
+		select {
+		case outcome := <-outcomeC:
+			failureFromRun := <-failureC
+			if outcome == types.SpecStatePassed {
+				return outcome, types.Failure{}
+			}
+			failure.Message, failure.Location, failure.ForwardedPanic = failureFromRun.Message, failureFromRun.Location, failureFromRun.ForwardedPanic
+			return outcome, failure
+		case <-sc.Done():
+			if isSpecTimeout {
+				failure.Message = "A spec timeout occurred"
+			} else {
+				failure.Message = "A node timeout occurred"
+			}
+			failure.Location = node.CodeLocation
+			state = types.SpecStateTimedout
+		case <-interruptChannel:
+			failure.Message, failure.Location = suite.interruptHandler.InterruptMessageWithStackTraces(), node.CodeLocation
+			state = types.SpecStateInterrupted
+			repeatedInterruptChannel = suite.interruptHandler.Status().Channel
+		case <-emitProgressNow:
+			suite.emitProgressReport()
+			emitProgressNow = nil
+			if suite.config.PollProgressInterval > 0 {
+				emitProgressNow = time.After(suite.config.PollProgressInterval)
+			}
+		}
+	}
 
+	sc.cancel()
+	if node.HasContext {
+		gracePeriod := suite.config.GracePeriod
+		if node.GracePeriod > 0 {
+			gracePeriod = node.GracePeriod
+		}
+		select {
+		case <-outcomeC:
+			<-failureC
+			return state, failure
+		case <-time.After(gracePeriod):
+			failure.Message += fmt.Sprintf("\n\nNode did not exit within grace period of %s after its context was cancelled.  Ginkgo has abandoned it and will ignore any subsequent failures it reports.", gracePeriod)
+		case <-repeatedInterruptChannel:
+			failure.Message += "\n\nNode was abandoned after a repeat interrupt before it could exit.  Ginkgo will ignore any subsequent failures it reports."
+		}
+	}
+
+	// the body is still running - we abandon it and make sure it can't affect the specs that run after it
+	stack := StackTraceForGoroutine(goroutineID)
+	suite.failer.Quarantine(goroutineID)
+	suite.failer.Drain()
 	select {
-	case outcome := <-outcomeC:
-		failureFromRun := <-failureC
-		if outcome == types.SpecStatePassed {
-			return outcome, types.Failure{}
+	case <-outcomeC:
+		<-failureC
+	default:
+		if node.HasContext && stack != "" {
+			failure.Message += "\n\nThe node's goroutine was running:\n" + stack
 		}
-		failure.Message, failure.Location, failure.ForwardedPanic = failureFromRun.Message, failureFromRun.Location, failureFromRun.ForwardedPanic
-		return outcome, failure
-	case <-interruptChannel:
-		failure.Message, failure.Location = suite.interruptHandler.InterruptMessageWithStackTraces(), node.CodeLocation
-		return types.SpecStateInterrupted, failure
 	}
+	return state, failure
 }
 
 func (suite *Suite) failureForLeafNodeWithMessage(node Node, message string) types.Failure {
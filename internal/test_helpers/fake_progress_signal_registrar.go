@@ -0,0 +1,40 @@
+package test_helpers
+
+import (
+	"context"
+	"sync"
+)
+
+/*
+FakeProgressSignalRegistrar stands in for internal.RegisterForProgressSignal - call Signal() to simulate the process receiving a progress signal
+*/
+type FakeProgressSignalRegistrar struct {
+	lock    *sync.Mutex
+	handler func()
+}
+
+func NewFakeProgressSignalRegistrar() *FakeProgressSignalRegistrar {
+	return &FakeProgressSignalRegistrar{
+		lock: &sync.Mutex{},
+	}
+}
+
+func (registrar *FakeProgressSignalRegistrar) RegisterForProgressSignal(handler func()) context.CancelFunc {
+	registrar.lock.Lock()
+	defer registrar.lock.Unlock()
+	registrar.handler = handler
+	return func() {
+		registrar.lock.Lock()
+		defer registrar.lock.Unlock()
+		registrar.handler = nil
+	}
+}
+
+func (registrar *FakeProgressSignalRegistrar) Signal() {
+	registrar.lock.Lock()
+	handler := registrar.handler
+	registrar.lock.Unlock()
+	if handler != nil {
+		handler()
+	}
+}
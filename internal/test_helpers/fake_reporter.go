@@ -2,6 +2,7 @@ package test_helpers
 
 import (
 	"reflect"
+	"sync"
 
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -78,11 +79,31 @@ func (s Reports) WithLeafNodeType(nodeTypes types.NodeType) Reports {
 	return out
 }
 
+type NodeEventRecord struct {
+	Report types.SpecReport
+	Event  types.NodeEvent
+}
+
+type NodeEventRecords []NodeEventRecord
+
+// Types returns the NodeType of each recorded event, in order
+func (r NodeEventRecords) Types() []types.NodeType {
+	out := []types.NodeType{}
+	for _, record := range r {
+		out = append(out, record.Event.NodeType)
+	}
+	return out
+}
+
 type FakeReporter struct {
-	Begin types.Report
-	Will  Reports
-	Did   Reports
-	End   types.Report
+	Begin           types.Report
+	Will            Reports
+	Did             Reports
+	WillNodes       NodeEventRecords
+	DidNodes        NodeEventRecords
+	End             types.Report
+	ProgressReports []types.ProgressReport
+	lock            sync.Mutex
 }
 
 func (r *FakeReporter) SuiteWillBegin(report types.Report) {
@@ -97,10 +118,30 @@ func (r *FakeReporter) DidRun(report types.SpecReport) {
 	r.Did = append(r.Did, report)
 }
 
+func (r *FakeReporter) NodeWillRun(report types.SpecReport, event types.NodeEvent) {
+	r.WillNodes = append(r.WillNodes, NodeEventRecord{Report: report, Event: event})
+}
+
+func (r *FakeReporter) NodeDidRun(report types.SpecReport, event types.NodeEvent) {
+	r.DidNodes = append(r.DidNodes, NodeEventRecord{Report: report, Event: event})
+}
+
 func (r *FakeReporter) SuiteDidEnd(report types.Report) {
 	r.End = report
 }
 
+func (r *FakeReporter) EmitProgressReport(progressReport types.ProgressReport) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.ProgressReports = append(r.ProgressReports, progressReport)
+}
+
+func (r *FakeReporter) EmittedProgressReports() []types.ProgressReport {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]types.ProgressReport{}, r.ProgressReports...)
+}
+
 type NSpecs int
 type NWillRun int
 type NPassed int
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/onsi-experimental/ginkgo/v2/formatter"
+	"github.com/onsi-experimental/ginkgo/v2/internal"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 )
@@ -77,6 +78,15 @@ func (rt *RunTracker) T(text string, callback ...func()) func() {
 	}
 }
 
+func (rt *RunTracker) TSC(text string, callback ...func(internal.SpecContext)) func(internal.SpecContext) {
+	return func(c internal.SpecContext) {
+		rt.Run(text)
+		if len(callback) > 0 {
+			callback[0](c)
+		}
+	}
+}
+
 func (rt *RunTracker) C(text string, callback ...func()) func(args []string, additionalArgs []string) {
 	return func(args []string, additionalArgs []string) {
 		rt.RunWithData(text, "Args", args, "AdditionalArgs", additionalArgs)
@@ -39,16 +39,21 @@ func (t *ginkgoTestingTProxy) Cleanup(f func()) {
 }
 
 func (t *ginkgoTestingTProxy) Setenv(key, value string) {
+	t.setenv(key, value)
+}
+
+// setenv is shared with ginkgoTB and expects to be called from a method that is invoked directly by the user
+func (t *ginkgoTestingTProxy) setenv(key, value string) {
 	originalValue, exists := os.LookupEnv(key)
 	if exists {
-		t.cleanup(os.Setenv, key, originalValue, internal.Offset(1))
+		t.cleanup(os.Setenv, key, originalValue, internal.Offset(2))
 	} else {
-		t.cleanup(os.Unsetenv, key, internal.Offset(1))
+		t.cleanup(os.Unsetenv, key, internal.Offset(2))
 	}
 
 	err := os.Setenv(key, value)
 	if err != nil {
-		t.fail(fmt.Sprintf("Failed to set environment variable: %v", err), 1)
+		t.fail(fmt.Sprintf("Failed to set environment variable: %v", err), 2)
 	}
 }
 
@@ -81,7 +86,7 @@ func (t *ginkgoTestingTProxy) Fatalf(format string, args ...interface{}) {
 }
 
 func (t *ginkgoTestingTProxy) Helper() {
-	// No-op
+	types.MarkAsHelper(1)
 }
 
 func (t *ginkgoTestingTProxy) Log(args ...interface{}) {
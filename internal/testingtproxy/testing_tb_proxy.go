@@ -0,0 +1,99 @@
+package testingtproxy
+
+import (
+	"io"
+	"testing"
+
+	"github.com/onsi-experimental/ginkgo/v2/internal"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+/*
+NewTB returns a testing.TB that forwards failures, skips, logging, cleanup, temporary directories and environment variables to Ginkgo.
+
+The returned value embeds tb so that it satisfies testing.TB.  Any methods not explicitly implemented here (e.g. methods added to testing.TB
+in newer versions of Go) are forwarded to tb.  tb may be nil, in which case calling such methods will panic.
+*/
+func NewTB(tb testing.TB, writer io.Writer, fail failFunc, skip skipFunc, cleanup cleanupFunc, report reportFunc, offset int) testing.TB {
+	return &ginkgoTB{
+		TB:    tb,
+		proxy: New(writer, fail, skip, cleanup, report, offset+1),
+	}
+}
+
+type ginkgoTB struct {
+	testing.TB
+	proxy *ginkgoTestingTProxy
+}
+
+func (t *ginkgoTB) Cleanup(f func()) {
+	t.proxy.cleanup(f, internal.Offset(1))
+}
+
+func (t *ginkgoTB) Setenv(key, value string) {
+	t.proxy.setenv(key, value)
+}
+
+func (t *ginkgoTB) Error(args ...interface{}) {
+	t.proxy.Error(args...)
+}
+
+func (t *ginkgoTB) Errorf(format string, args ...interface{}) {
+	t.proxy.Errorf(format, args...)
+}
+
+func (t *ginkgoTB) Fail() {
+	t.proxy.Fail()
+}
+
+func (t *ginkgoTB) FailNow() {
+	t.proxy.FailNow()
+}
+
+func (t *ginkgoTB) Failed() bool {
+	return t.proxy.Failed()
+}
+
+func (t *ginkgoTB) Fatal(args ...interface{}) {
+	t.proxy.Fatal(args...)
+}
+
+func (t *ginkgoTB) Fatalf(format string, args ...interface{}) {
+	t.proxy.Fatalf(format, args...)
+}
+
+func (t *ginkgoTB) Helper() {
+	types.MarkAsHelper(1)
+}
+
+func (t *ginkgoTB) Log(args ...interface{}) {
+	t.proxy.Log(args...)
+}
+
+func (t *ginkgoTB) Logf(format string, args ...interface{}) {
+	t.proxy.Logf(format, args...)
+}
+
+func (t *ginkgoTB) Name() string {
+	return t.proxy.Name()
+}
+
+func (t *ginkgoTB) Skip(args ...interface{}) {
+	t.proxy.Skip(args...)
+}
+
+func (t *ginkgoTB) SkipNow() {
+	t.proxy.SkipNow()
+}
+
+func (t *ginkgoTB) Skipf(format string, args ...interface{}) {
+	t.proxy.Skipf(format, args...)
+}
+
+func (t *ginkgoTB) Skipped() bool {
+	return t.proxy.Skipped()
+}
+
+func (t *ginkgoTB) TempDir() string {
+	return t.proxy.TempDir()
+}
@@ -2,6 +2,8 @@ package testingtproxy_test
 
 import (
 	"os"
+	"runtime"
+	"testing"
 
 	. "github.com/onsi-experimental/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -164,8 +166,15 @@ var _ = Describe("Testingtproxy", func() {
 		Ω(failFuncCall.callerSkip).Should(Equal([]int{offset}))
 	})
 
-	It("ignores Helper", func() {
-		GinkgoT().Helper() //is a no-op
+	It("marks the caller as a helper when Helper is called", func() {
+		var location types.CodeLocation
+		helper := func() {
+			t.Helper()
+			location = types.NewCodeLocation(0)
+		}
+		_, _, expectedLine, _ := runtime.Caller(0)
+		helper()
+		Ω(location.LineNumber).Should(Equal(expectedLine + 1))
 	})
 
 	It("supports Log", func() {
@@ -214,3 +223,113 @@ var _ = Describe("Testingtproxy", func() {
 		Ω(t.Skipped()).Should(BeTrue())
 	})
 })
+
+type fakeTB struct {
+	testing.TB
+	didCallHelper bool
+}
+
+func (f *fakeTB) Helper() {
+	f.didCallHelper = true
+}
+
+var _ = Describe("TestingTB proxy", func() {
+	var tb testing.TB
+	var embedded *fakeTB
+
+	var failFuncCall messagedCall
+	var skipFuncCall messagedCall
+	var buf *gbytes.Buffer
+	const offset = 3
+
+	BeforeEach(func() {
+		failFuncCall = messagedCall{}
+		skipFuncCall = messagedCall{}
+		buf = gbytes.NewBuffer()
+		embedded = &fakeTB{}
+
+		failFunc := func(message string, callerSkip ...int) {
+			failFuncCall.message = message
+			failFuncCall.callerSkip = callerSkip
+		}
+		skipFunc := func(message string, callerSkip ...int) {
+			skipFuncCall.message = message
+			skipFuncCall.callerSkip = callerSkip
+		}
+		reportFunc := func() types.SpecReport {
+			return types.SpecReport{LeafNodeText: "Lewis", State: types.SpecStateSkipped}
+		}
+
+		tb = testingtproxy.NewTB(embedded, buf, failFunc, skipFunc, DeferCleanup, reportFunc, offset)
+	})
+
+	It("forwards failures and skips to Ginkgo, accounting for the extra stack frame", func() {
+		tb.Errorf("%s %d!", "a", 17)
+		Ω(failFuncCall.message).Should(Equal("a 17!"))
+		Ω(failFuncCall.callerSkip).Should(Equal([]int{offset + 1}))
+
+		tb.FailNow()
+		Ω(failFuncCall.message).Should(Equal("failed"))
+		Ω(failFuncCall.callerSkip).Should(Equal([]int{offset + 1}))
+
+		tb.Skip("a", 17)
+		Ω(skipFuncCall.message).Should(Equal("a 17\n"))
+		Ω(skipFuncCall.callerSkip).Should(Equal([]int{offset + 1}))
+	})
+
+	It("forwards logs to the writer", func() {
+		tb.Logf("%s %d!", "a", 17)
+		Ω(string(buf.Contents())).Should(Equal("a 17!\n"))
+	})
+
+	It("reports the spec's name and state", func() {
+		Ω(tb.Name()).Should(Equal("Lewis"))
+		Ω(tb.Skipped()).Should(BeTrue())
+	})
+
+	It("does not forward methods that Ginkgo implements to the embedded testing.TB", func() {
+		var location types.CodeLocation
+		helper := func() {
+			tb.Helper()
+			location = types.NewCodeLocation(0)
+		}
+		_, _, expectedLine, _ := runtime.Caller(0)
+		helper()
+		Ω(location.LineNumber).Should(Equal(expectedLine + 1))
+		Ω(embedded.didCallHelper).Should(BeFalse())
+	})
+
+	Describe("Cleanup, TempDir, and Setenv", Ordered, func() {
+		const key = "FLOOP_FLARP_WIBBLE_BLARP_TB"
+		var tempDir string
+		var didCleanup bool
+
+		BeforeAll(func() {
+			os.Unsetenv(key)
+		})
+
+		It("are scoped to the current spec", func() {
+			tb.Cleanup(func() { didCleanup = true })
+			tempDir = tb.TempDir()
+			tb.Setenv(key, "HELLO")
+			Ω(tempDir).Should(BeADirectory())
+			Ω(os.Getenv(key)).Should(Equal("HELLO"))
+			Ω(didCleanup).Should(BeFalse())
+		})
+
+		It("are cleaned up after the spec", func() {
+			Ω(didCleanup).Should(BeTrue())
+			Ω(tempDir).ShouldNot(BeADirectory())
+			_, exists := os.LookupEnv(key)
+			Ω(exists).Should(BeFalse())
+		})
+	})
+
+	It("is returned by GinkgoTB, which can be passed to functions that accept a testing.TB", func() {
+		var helper = func(t testing.TB) string {
+			t.Helper()
+			return t.Name()
+		}
+		Ω(helper(GinkgoTB())).Should(ContainSubstring("can be passed to functions that accept a testing.TB"))
+	})
+})
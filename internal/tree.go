@@ -73,5 +73,7 @@ func GenerateSpecsFromTreeRoot(tree *TreeNode) Specs {
 		return tests
 	}
 
-	return walkTree(0, Nodes{}, Nodes{}, tree.Children)
+	specs := walkTree(0, Nodes{}, Nodes{}, tree.Children)
+	specs.AssignIDs()
+	return specs
 }
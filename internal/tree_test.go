@@ -167,6 +167,13 @@ var _ = Describe("Trees (TreeNode and TreeNodes)", func() {
 				}
 			})
 
+			It("assigns each spec a stable ID", func() {
+				for i := range tests {
+					Ω(tests[i].ID).ShouldNot(BeEmpty())
+				}
+				Ω(internal.GenerateSpecsFromTreeRoot(tree)[2].ID).Should(Equal(tests[2].ID))
+			})
+
 			It("ensures each node as the correct nesting level", func() {
 				extpectedNestingLevels := [][]int{
 					{0, 0, 0},
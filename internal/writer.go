@@ -19,8 +19,15 @@ type WriterInterface interface {
 
 	Truncate()
 	Bytes() []byte
+
+	Fork() WriterInterface
+	RouteTo(route WriterRoute)
 }
 
+// WriterRoute returns the writer that a write made on the current goroutine should be forwarded to.
+// It returns nil if the write should be handled by the Writer the route is attached to.
+type WriterRoute func() WriterInterface
+
 //Writer impplements WriterInterface and GinkgoWriterInterface
 type Writer struct {
 	buffer    *bytes.Buffer
@@ -29,6 +36,7 @@ type Writer struct {
 	mode      WriterMode
 
 	teeWriters []io.Writer
+	route      WriterRoute
 }
 
 func NewWriter(outWriter io.Writer) *Writer {
@@ -46,7 +54,42 @@ func (w *Writer) SetMode(mode WriterMode) {
 	w.mode = mode
 }
 
+/*
+Fork returns a new Writer that shares w's output, mode, and tee writers but has its own buffer.
+
+Ginkgo forks the GinkgoWriter for each goroutine when running specs with --parallel-mode=goroutines so that the output of each spec can be
+captured independently.
+*/
+func (w *Writer) Fork() WriterInterface {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return &Writer{
+		buffer:     &bytes.Buffer{},
+		lock:       &sync.Mutex{},
+		outWriter:  w.outWriter,
+		mode:       w.mode,
+		teeWriters: append([]io.Writer{}, w.teeWriters...),
+	}
+}
+
+// RouteTo instructs w to forward writes to the writer returned by route.  Pass in nil to stop routing.
+func (w *Writer) RouteTo(route WriterRoute) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.route = route
+}
+
 func (w *Writer) Write(b []byte) (n int, err error) {
+	w.lock.Lock()
+	route := w.route
+	w.lock.Unlock()
+	if route != nil {
+		if target := route(); target != nil && target != WriterInterface(w) {
+			return target.Write(b)
+		}
+	}
+
 	w.lock.Lock()
 	defer w.lock.Unlock()
 
@@ -92,6 +92,49 @@ var _ = Describe("Writer", func() {
 		})
 	})
 
+	Describe("Forking", func() {
+		It("returns a writer with the same configuration but its own buffer", func() {
+			tee := gbytes.NewBuffer()
+			writer.SetMode(internal.WriterModeBufferOnly)
+			writer.TeeTo(tee)
+			writer.Print("parent")
+
+			fork := writer.Fork()
+			fork.Write([]byte("fork"))
+			Ω(string(fork.Bytes())).Should(Equal("fork"))
+			Ω(string(writer.Bytes())).Should(Equal("parent"))
+			Ω(string(tee.Contents())).Should(Equal("parentfork"))
+			Ω(out.Contents()).Should(BeEmpty())
+		})
+	})
+
+	Describe("Routing", func() {
+		var target internal.WriterInterface
+		BeforeEach(func() {
+			target = writer.Fork()
+			writer.RouteTo(func() internal.WriterInterface { return target })
+		})
+
+		It("forwards writes to the writer returned by the route", func() {
+			writer.Print("routed")
+			Ω(string(target.Bytes())).Should(Equal("routed"))
+			Ω(writer.Bytes()).Should(BeEmpty())
+		})
+
+		It("writes to itself when the route returns nil", func() {
+			target = nil
+			writer.Print("not routed")
+			Ω(string(writer.Bytes())).Should(Equal("not routed"))
+		})
+
+		It("stops routing when the route is cleared", func() {
+			writer.RouteTo(nil)
+			writer.Print("not routed")
+			Ω(string(writer.Bytes())).Should(Equal("not routed"))
+			Ω(target.Bytes()).Should(BeEmpty())
+		})
+	})
+
 	Describe("Convenience print methods", func() {
 		It("can Print", func() {
 			writer.Print("foo", "baz", " ", "bizzle")
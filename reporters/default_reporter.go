@@ -12,6 +12,7 @@ import (
 	"io"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/onsi-experimental/ginkgo/v2/formatter"
 	"github.com/onsi-experimental/ginkgo/v2/types"
@@ -67,6 +68,9 @@ func (r *DefaultReporter) SuiteWillBegin(report types.Report) {
 		if report.SuiteConfig.ParallelTotal > 1 {
 			r.emit(r.f("- %d procs ", report.SuiteConfig.ParallelTotal))
 		}
+		if numGoroutines := report.SuiteConfig.ComputedParallelGoroutines(); numGoroutines > 1 {
+			r.emit(r.f("- %d goroutines ", numGoroutines))
+		}
 	} else {
 		banner := r.f("Running Suite: %s - %s", report.SuiteDescription, report.SuitePath)
 		r.emitBlock(banner)
@@ -82,6 +86,9 @@ func (r *DefaultReporter) SuiteWillBegin(report types.Report) {
 		if report.SuiteConfig.ParallelTotal > 1 {
 			r.emitBlock(r.f("Running in parallel across {{bold}}%d{{/}} processes", report.SuiteConfig.ParallelTotal))
 		}
+		if numGoroutines := report.SuiteConfig.ComputedParallelGoroutines(); numGoroutines > 1 {
+			r.emitBlock(r.f("Running in parallel across {{bold}}%d{{/}} goroutines", numGoroutines))
+		}
 	}
 }
 
@@ -118,6 +125,7 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 	hasGW := report.CapturedGinkgoWriterOutput != ""
 	hasStd := report.CapturedStdOutErr != ""
 	hasEmittableReports := report.ReportEntries.HasVisibility(types.ReportEntryVisibilityAlways) || (report.ReportEntries.HasVisibility(types.ReportEntryVisibilityFailureOrVerbose) && (!report.Failure.IsZero() || v.GTE(types.VerbosityLevelVerbose)))
+	hasSteps := len(report.Steps) > 0 && report.State.Is(types.SpecStateFailureStates)
 
 	if report.LeafNodeType.Is(types.NodeTypesForSuiteLevelNodes) {
 		denoter = fmt.Sprintf("[%s]", report.LeafNodeType)
@@ -135,7 +143,7 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 			}
 		} else {
 			header, stream = denoter, true
-			if report.NumAttempts > 1 {
+			if report.NumAttempts > 1 && report.MaxMustPassRepeatedly == 0 {
 				header, stream = fmt.Sprintf("%s [FLAKEY TEST - TOOK %d ATTEMPTS TO PASS]", r.retryDenoter, report.NumAttempts), false
 			}
 			if report.RunTime > r.conf.SlowSpecThreshold {
@@ -162,6 +170,8 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 		}
 	case types.SpecStateFailed:
 		highlightColor, header = "{{red}}", fmt.Sprintf("%s [FAILED]", denoter)
+	case types.SpecStateTimedout:
+		highlightColor, header = "{{orange}}", fmt.Sprintf("%s [TIMEDOUT]", denoter)
 	case types.SpecStatePanicked:
 		highlightColor, header = "{{magenta}}", fmt.Sprintf("%s! [PANICKED]", denoter)
 	case types.SpecStateInterrupted:
@@ -170,6 +180,10 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 		highlightColor, header = "{{coral}}", fmt.Sprintf("%s! [ABORTED]", denoter)
 	}
 
+	if report.State.Is(types.SpecStateFailureStates) && report.MaxMustPassRepeatedly > 1 {
+		header = fmt.Sprintf("%s [REPEATED TEST - FAILED ON ATTEMPT #%d OF %d]", header, report.NumAttempts, report.MaxMustPassRepeatedly)
+	}
+
 	// Emit stream and return
 	if stream {
 		r.emit(r.f(highlightColor + header + "{{/}}"))
@@ -218,11 +232,26 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 		r.emitBlock(r.fi(1, "{{gray}}<< End Report Entries{{/}}"))
 	}
 
+	//Emit Step Timeline
+	if hasSteps {
+		r.emitBlock("\n")
+		r.emitBlock(r.fi(1, "{{gray}}Begin Step Timeline >>{{/}}"))
+		for _, step := range report.Steps {
+			r.emitBlock(r.fi(2+uint(step.NestingLevel), "{{bold}}STEP:{{/}} %s {{gray}}[%.3f seconds] - %s @ %s{{/}}", step.Text, step.Duration.Seconds(), step.CodeLocation, step.StartTime.Format(types.GINKGO_TIME_FORMAT)))
+		}
+		r.emitBlock(r.fi(1, "{{gray}}<< End Step Timeline{{/}}"))
+	}
+
 	// Emit Failure Message
 	if !report.Failure.IsZero() {
 		r.emitBlock("\n")
 		r.emitBlock(r.fi(1, highlightColor+"%s{{/}}", report.Failure.Message))
-		r.emitBlock(r.fi(1, highlightColor+"In {{bold}}[%s]{{/}}"+highlightColor+" at: {{bold}}%s{{/}}\n", report.Failure.FailureNodeType, report.Failure.Location))
+		if report.Failure.FailureStepText != "" {
+			r.emitBlock(r.fi(1, highlightColor+"In {{bold}}[%s]{{/}}"+highlightColor+" at: {{bold}}%s{{/}}", report.Failure.FailureNodeType, report.Failure.Location))
+			r.emitBlock(r.fi(1, highlightColor+"During step {{bold}}%s{{/}}"+highlightColor+" at: {{bold}}%s{{/}}\n", report.Failure.FailureStepText, report.Failure.FailureStepLocation))
+		} else {
+			r.emitBlock(r.fi(1, highlightColor+"In {{bold}}[%s]{{/}}"+highlightColor+" at: {{bold}}%s{{/}}\n", report.Failure.FailureNodeType, report.Failure.Location))
+		}
 		if report.Failure.ForwardedPanic != "" {
 			r.emitBlock("\n")
 			r.emitBlock(r.fi(1, highlightColor+"%s{{/}}", report.Failure.ForwardedPanic))
@@ -252,6 +281,8 @@ func (r *DefaultReporter) SuiteDidEnd(report types.Report) {
 				highlightColor, heading = "{{coral}}", "[ABORTED]"
 			case types.SpecStateInterrupted:
 				highlightColor, heading = "{{orange}}", "[INTERRUPTED]"
+			case types.SpecStateTimedout:
+				highlightColor, heading = "{{orange}}", "[TIMEDOUT]"
 			}
 			locationBlock := r.codeLocationBlock(specReport, highlightColor, true, true)
 			r.emitBlock(r.fi(1, highlightColor+"%s{{/}} %s", heading, locationBlock))
@@ -299,6 +330,64 @@ func (r *DefaultReporter) SuiteDidEnd(report types.Report) {
 	}
 }
 
+func (r *DefaultReporter) EmitProgressReport(report types.ProgressReport) {
+	r.emitDelimiter()
+
+	if report.RunningInParallel {
+		r.emitBlock(r.f("{{coral}}Progress Report for Ginkgo Process #{{bold}}%d{{/}}", report.ParallelProcess))
+	} else {
+		r.emitBlock(r.f("{{coral}}Progress Report{{/}}"))
+	}
+
+	if report.IsZero() {
+		r.emitBlock(r.fi(1, "{{gray}}No spec is currently running{{/}}"))
+		r.emitDelimiter()
+		return
+	}
+
+	if report.LeafNodeType.Is(types.NodeTypesForSuiteLevelNodes) {
+		r.emitBlock(r.fi(1, "{{bold}}[%s] %s{{/}} (Spec Runtime: %s)", report.LeafNodeType, report.LeafNodeText, runtimeSince(report.SpecStartTime)))
+	} else {
+		hierarchy := ""
+		if len(report.ContainerHierarchyTexts) > 0 {
+			hierarchy = r.cycleJoin(report.ContainerHierarchyTexts, " ") + " "
+		}
+		r.emitBlock(r.fi(1, "%s{{bold}}%s{{/}} (Spec Runtime: %s)", hierarchy, report.LeafNodeText, runtimeSince(report.SpecStartTime)))
+	}
+	r.emitBlock(r.fi(2, "{{gray}}%s{{/}}", report.LeafNodeLocation))
+
+	nodeText := ""
+	if report.CurrentNodeText != "" && report.CurrentNodeText != report.LeafNodeText {
+		nodeText = " " + report.CurrentNodeText
+	}
+	r.emitBlock(r.fi(2, "In {{bold}}[%s]%s{{/}} (Node Runtime: %s)", report.CurrentNodeType, nodeText, runtimeSince(report.CurrentNodeStartTime)))
+	r.emitBlock(r.fi(3, "{{gray}}%s{{/}}", report.CurrentNodeLocation))
+
+	if report.CurrentStepText != "" {
+		r.emitBlock(r.fi(3, "At {{bold}}[By Step] %s{{/}} (Step Runtime: %s)", report.CurrentStepText, runtimeSince(report.CurrentStepStartTime)))
+		r.emitBlock(r.fi(4, "{{gray}}%s{{/}}", report.CurrentStepLocation))
+	}
+
+	if report.CapturedGinkgoWriterOutput != "" {
+		r.emitBlock("\n")
+		r.emitBlock(r.fi(1, "{{gray}}Begin Captured GinkgoWriter Output >>{{/}}"))
+		r.emitBlock(r.fi(2, "%s", report.CapturedGinkgoWriterOutput))
+		r.emitBlock(r.fi(1, "{{gray}}<< End Captured GinkgoWriter Output{{/}}"))
+	}
+
+	if report.SpecGoroutineStack != "" {
+		r.emitBlock("\n")
+		r.emitBlock(r.fi(1, "{{bold}}Spec Goroutine{{/}}"))
+		r.emitBlock(r.fi(2, "%s", report.SpecGoroutineStack))
+	}
+
+	r.emitDelimiter()
+}
+
+func runtimeSince(t time.Time) time.Duration {
+	return time.Since(t).Round(100 * time.Millisecond)
+}
+
 /* Emitting to the writer */
 func (r *DefaultReporter) emit(s string) {
 	if len(s) > 0 {
@@ -55,6 +55,8 @@ func F(options ...interface{}) types.Failure {
 			failure.FailureNodeLocation = types.CodeLocation(option.(FailureNodeLocation))
 		case reflect.TypeOf(types.NodeTypeIt):
 			failure.FailureNodeType = option.(types.NodeType)
+		case reflect.TypeOf(types.SpecStep{}):
+			failure.FailureStepText, failure.FailureStepLocation = option.(types.SpecStep).Text, option.(types.SpecStep).CodeLocation
 		}
 	}
 	return failure
@@ -98,12 +100,16 @@ func S(options ...interface{}) types.SpecReport {
 			report.Failure = option.(types.Failure)
 		case reflect.TypeOf(0):
 			report.NumAttempts = option.(int)
+		case reflect.TypeOf(MustPassRepeatedly(0)):
+			report.MaxMustPassRepeatedly = int(option.(MustPassRepeatedly))
 		case reflect.TypeOf(STD("")):
 			report.CapturedStdOutErr = string(option.(STD))
 		case reflect.TypeOf(GW("")):
 			report.CapturedGinkgoWriterOutput = string(option.(GW))
 		case reflect.TypeOf(types.ReportEntry{}):
 			report.ReportEntries = append(report.ReportEntries, option.(types.ReportEntry))
+		case reflect.TypeOf(types.SpecStep{}):
+			report.Steps = append(report.Steps, option.(types.SpecStep))
 		}
 	}
 	if len(report.ContainerHierarchyLabels) == 0 {
@@ -120,6 +126,10 @@ func RE(name string, cl types.CodeLocation, args ...interface{}) types.ReportEnt
 	return entry
 }
 
+func Step(text string, cl types.CodeLocation, nestingLevel int, duration time.Duration) types.SpecStep {
+	return types.SpecStep{Text: text, CodeLocation: cl, NestingLevel: nestingLevel, StartTime: PLACEHOLDER_TIME, Duration: duration}
+}
+
 type ConfigFlags uint8
 
 const (
@@ -299,6 +309,11 @@ var _ = Describe("DefaultReporter", func() {
 			S("A", cl0),
 			"{{green}}"+DENOTER+"{{/}}",
 		),
+		Entry("a passing test with steps does not emit the step timeline",
+			C(),
+			S(CTS("A"), "B", CLS(cl0), cl1, Step("a step", cl2, 0, time.Second)),
+			"{{green}}"+DENOTER+"{{/}}",
+		),
 		Entry("a passing test that was retried",
 			C(),
 			S(CTS("A"), "B", CLS(cl0), cl1, 2),
@@ -309,6 +324,11 @@ var _ = Describe("DefaultReporter", func() {
 			DELIMITER,
 			"",
 		),
+		Entry("a passing test that was repeated",
+			C(),
+			S("A", cl0, 3, MustPassRepeatedly(3)),
+			"{{green}}"+DENOTER+"{{/}}",
+		),
 		Entry("a passing test that has ginkgo writer output and/or non-visible report entries",
 			C(),
 			S("A", cl0, GW("GINKGO-WRITER-OUTPUT"), RE("fail-report-name", cl1, types.ReportEntryVisibilityFailureOrVerbose), RE("hidden-report-name", cl2, types.ReportEntryVisibilityNever)),
@@ -669,6 +689,52 @@ var _ = Describe("DefaultReporter", func() {
 			DELIMITER,
 			"",
 		),
+		Entry("when a test has failed during a step",
+			C(),
+			S(CTS("Describe A"), "The Test", CLS(cl0), cl1,
+				types.SpecStateFailed,
+				Step("first step", cl2, 0, time.Second),
+				Step("outer step", cl2, 0, 2*time.Second),
+				Step("inner step", cl3, 1, 500*time.Millisecond),
+				F("FAILURE MESSAGE", types.FailureNodeIsLeafNode, types.NodeTypeIt, FailureNodeLocation(cl1), cl4, Step("inner step", cl3, 1, 0)),
+			),
+			DELIMITER,
+			"{{red}}"+DENOTER+" [FAILED] [1.000 seconds]{{/}}",
+			"Describe A",
+			"{{gray}}"+cl0.String()+"{{/}}",
+			"  {{red}}{{bold}}[It] The Test{{/}}",
+			"  {{gray}}"+cl1.String()+"{{/}}",
+			"",
+			"  {{gray}}Begin Step Timeline >>{{/}}",
+			"    {{bold}}STEP:{{/}} first step {{gray}}[1.000 seconds] - "+cl2.String()+" @ "+FORMATTED_TIME+"{{/}}",
+			"    {{bold}}STEP:{{/}} outer step {{gray}}[2.000 seconds] - "+cl2.String()+" @ "+FORMATTED_TIME+"{{/}}",
+			"      {{bold}}STEP:{{/}} inner step {{gray}}[0.500 seconds] - "+cl3.String()+" @ "+FORMATTED_TIME+"{{/}}",
+			"  {{gray}}<< End Step Timeline{{/}}",
+			"",
+			"  {{red}}FAILURE MESSAGE{{/}}",
+			"  {{red}}In {{bold}}[It]{{/}}{{red}} at: {{bold}}"+cl4.String()+"{{/}}",
+			"  {{red}}During step {{bold}}inner step{{/}}{{red}} at: {{bold}}"+cl3.String()+"{{/}}",
+			DELIMITER,
+			"",
+		),
+		Entry("when a repeated test fails",
+			C(),
+			S(CTS("Describe A"), "The Test", CLS(cl0), cl1,
+				types.SpecStateFailed, 2, MustPassRepeatedly(5),
+				F("FAILURE MESSAGE", types.FailureNodeIsLeafNode, types.NodeTypeIt, FailureNodeLocation(cl1), cl2),
+			),
+			DELIMITER,
+			"{{red}}"+DENOTER+" [FAILED] [REPEATED TEST - FAILED ON ATTEMPT #2 OF 5] [1.000 seconds]{{/}}",
+			"Describe A",
+			"{{gray}}"+cl0.String()+"{{/}}",
+			"  {{red}}{{bold}}[It] The Test{{/}}",
+			"  {{gray}}"+cl1.String()+"{{/}}",
+			"",
+			"  {{red}}FAILURE MESSAGE{{/}}",
+			"  {{red}}In {{bold}}[It]{{/}}{{red}} at: {{bold}}"+cl2.String()+"{{/}}",
+			DELIMITER,
+			"",
+		),
 		Entry("when a test has failed in a setup/teardown node",
 			C(),
 			S(CTS("Describe A", "Context B"), "The Test", CLS(cl0, cl1), cl2,
@@ -958,6 +1024,39 @@ var _ = Describe("DefaultReporter", func() {
 			"",
 		),
 
+		Entry("when a test times out",
+			C(),
+			S(CTS("Describe A", "Context B"), "The Test", CLS(cl0, cl1), cl2,
+				types.SpecStateTimedout, 2,
+				GW("GW-OUTPUT\nIS EMITTED"), STD("STD-OUTPUT\nIS EMITTED"),
+				F("FAILURE MESSAGE\nWITH DETAILS", types.FailureNodeInContainer, FailureNodeLocation(cl3), types.NodeTypeJustBeforeEach, 1, cl4),
+			),
+			DELIMITER,
+			"{{orange}}"+DENOTER+" [TIMEDOUT] [1.000 seconds]{{/}}",
+			"Describe A",
+			"{{gray}}"+cl0.String()+"{{/}}",
+			"  {{orange}}{{bold}}Context B [JustBeforeEach]{{/}}",
+			"  {{gray}}"+cl3.String()+"{{/}}",
+			"    The Test",
+			"    {{gray}}"+cl2.String()+"{{/}}",
+			"",
+			"  {{gray}}Begin Captured StdOut/StdErr Output >>{{/}}",
+			"    STD-OUTPUT",
+			"    IS EMITTED",
+			"  {{gray}}<< End Captured StdOut/StdErr Output{{/}}",
+			"",
+			"  {{gray}}Begin Captured GinkgoWriter Output >>{{/}}",
+			"    GW-OUTPUT",
+			"    IS EMITTED",
+			"  {{gray}}<< End Captured GinkgoWriter Output{{/}}",
+			"",
+			"  {{orange}}FAILURE MESSAGE",
+			"  WITH DETAILS{{/}}",
+			"  {{orange}}In {{bold}}[JustBeforeEach]{{/}}{{orange}} at: {{bold}}"+cl4.String()+"{{/}}",
+			DELIMITER,
+			"",
+		),
+
 		Entry("when a test is aborted",
 			C(),
 			S(CTS("Describe A", "Context B"), "The Test", CLS(cl0, cl1), cl2,
@@ -991,6 +1090,102 @@ var _ = Describe("DefaultReporter", func() {
 			"",
 		))
 
+	DescribeTable("EmitProgressReport",
+		func(conf types.ReporterConfig, report types.ProgressReport, expected ...string) {
+			reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+			reporter.EmitProgressReport(report)
+			verifyExpectedOutput(expected)
+		},
+		Entry("when no spec is running",
+			C(),
+			types.ProgressReport{ParallelProcess: 1},
+			DELIMITER,
+			"{{coral}}Progress Report{{/}}",
+			"  {{gray}}No spec is currently running{{/}}",
+			DELIMITER,
+			"",
+		),
+		Entry("when a spec is running",
+			C(),
+			types.ProgressReport{
+				ContainerHierarchyTexts: []string{"Container", "Nested Container"},
+				LeafNodeType:            types.NodeTypeIt,
+				LeafNodeText:            "My Test",
+				LeafNodeLocation:        cl0,
+				SpecStartTime:           time.Now().Add(-5 * time.Second),
+				CurrentNodeType:         types.NodeTypeBeforeEach,
+				CurrentNodeLocation:     cl1,
+				CurrentNodeStartTime:    time.Now().Add(-3 * time.Second),
+			},
+			DELIMITER,
+			"{{coral}}Progress Report{{/}}",
+			"  {{/}}Container {{gray}}Nested Container{{/}} {{bold}}My Test{{/}} (Spec Runtime: 5s)",
+			"    {{gray}}"+cl0.String()+"{{/}}",
+			"    In {{bold}}[BeforeEach]{{/}} (Node Runtime: 3s)",
+			"      {{gray}}"+cl1.String()+"{{/}}",
+			DELIMITER,
+			"",
+		),
+		Entry("when running in parallel with a step, GinkgoWriter output, and a goroutine stack",
+			C(),
+			types.ProgressReport{
+				ParallelProcess:            3,
+				RunningInParallel:          true,
+				LeafNodeType:               types.NodeTypeIt,
+				LeafNodeText:               "My Test",
+				LeafNodeLocation:           cl0,
+				SpecStartTime:              time.Now().Add(-5 * time.Second),
+				CurrentNodeType:            types.NodeTypeIt,
+				CurrentNodeText:            "My Test",
+				CurrentNodeLocation:        cl0,
+				CurrentNodeStartTime:       time.Now().Add(-3 * time.Second),
+				CurrentStepText:            "doing the thing",
+				CurrentStepLocation:        cl2,
+				CurrentStepStartTime:       time.Now().Add(-1500 * time.Millisecond),
+				CapturedGinkgoWriterOutput: "gw-1\ngw-2",
+				SpecGoroutineStack:         "goroutine 17 [running]:\nstack",
+			},
+			DELIMITER,
+			"{{coral}}Progress Report for Ginkgo Process #{{bold}}3{{/}}",
+			"  {{bold}}My Test{{/}} (Spec Runtime: 5s)",
+			"    {{gray}}"+cl0.String()+"{{/}}",
+			"    In {{bold}}[It]{{/}} (Node Runtime: 3s)",
+			"      {{gray}}"+cl0.String()+"{{/}}",
+			"      At {{bold}}[By Step] doing the thing{{/}} (Step Runtime: 1.5s)",
+			"        {{gray}}"+cl2.String()+"{{/}}",
+			"",
+			"  {{gray}}Begin Captured GinkgoWriter Output >>{{/}}",
+			"    gw-1",
+			"    gw-2",
+			"  {{gray}}<< End Captured GinkgoWriter Output{{/}}",
+			"",
+			"  {{bold}}Spec Goroutine{{/}}",
+			"    goroutine 17 [running]:",
+			"    stack",
+			DELIMITER,
+			"",
+		),
+		Entry("when a suite-level node is running",
+			C(),
+			types.ProgressReport{
+				LeafNodeType:         types.NodeTypeBeforeSuite,
+				LeafNodeLocation:     cl0,
+				SpecStartTime:        time.Now().Add(-2 * time.Second),
+				CurrentNodeType:      types.NodeTypeBeforeSuite,
+				CurrentNodeLocation:  cl0,
+				CurrentNodeStartTime: time.Now().Add(-2 * time.Second),
+			},
+			DELIMITER,
+			"{{coral}}Progress Report{{/}}",
+			"  {{bold}}[BeforeSuite] {{/}} (Spec Runtime: 2s)",
+			"    {{gray}}"+cl0.String()+"{{/}}",
+			"    In {{bold}}[BeforeSuite]{{/}} (Node Runtime: 2s)",
+			"      {{gray}}"+cl0.String()+"{{/}}",
+			DELIMITER,
+			"",
+		),
+	)
+
 	DescribeTable("Rendering SuiteDidEnd",
 		func(conf types.ReporterConfig, report types.Report, expected ...string) {
 			reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
@@ -1074,7 +1269,7 @@ var _ = Describe("DefaultReporter", func() {
 			C(),
 			types.Report{
 				SuiteSucceeded: false,
-				PreRunStats:    types.PreRunStats{TotalSpecs: 13, SpecsThatWillRun: 9},
+				PreRunStats:    types.PreRunStats{TotalSpecs: 14, SpecsThatWillRun: 10},
 				RunTime:        time.Minute,
 				SpecReports: types.SpecReports{
 					S(types.NodeTypeBeforeSuite),
@@ -1098,12 +1293,16 @@ var _ = Describe("DefaultReporter", func() {
 						types.SpecStateAborted, 2,
 						F("FAILURE MESSAGE\nWITH DETAILS", types.FailureNodeIsLeafNode, FailureNodeLocation(cl0), types.NodeTypeIt, cl1),
 					),
+					S("The Test", cl0,
+						types.SpecStateTimedout, 2,
+						F("FAILURE MESSAGE\nWITH DETAILS", types.FailureNodeIsLeafNode, FailureNodeLocation(cl0), types.NodeTypeIt, cl1),
+					),
 					S(types.NodeTypeAfterSuite),
 				},
 			},
 			"",
 			"",
-			"{{red}}{{bold}}Summarizing 4 Failures:{{/}}",
+			"{{red}}{{bold}}Summarizing 5 Failures:{{/}}",
 			"  {{red}}[FAIL]{{/}} {{/}}Describe A {{gray}}{{red}}{{bold}}Context B [JustBeforeEach]{{/}} {{/}}The Test{{/}} {{coral}}[cat, dog, fish, giraffe]{{/}}",
 			"  {{gray}}"+cl4.String()+"{{/}}",
 			"  {{magenta}}[PANICKED!]{{/}} {{/}}Describe A {{gray}}{{magenta}}{{bold}}[It] The Test{{/}}{{/}}",
@@ -1112,9 +1311,11 @@ var _ = Describe("DefaultReporter", func() {
 			"  {{gray}}"+cl1.String()+"{{/}}",
 			"  {{coral}}[ABORTED]{{/}} {{/}}{{coral}}{{bold}}[It] The Test{{/}}{{/}}",
 			"  {{gray}}"+cl1.String()+"{{/}}",
+			"  {{orange}}[TIMEDOUT]{{/}} {{/}}{{orange}}{{bold}}[It] The Test{{/}}{{/}}",
+			"  {{gray}}"+cl1.String()+"{{/}}",
 			"",
-			"{{red}}{{bold}}Ran 9 of 13 Specs in 60.000 seconds{{/}}",
-			"{{red}}{{bold}}FAIL!{{/}} -- {{green}}{{bold}}5 Passed{{/}} | {{red}}{{bold}}4 Failed{{/}} | {{light-yellow}}{{bold}}2 Flaked{{/}} | {{yellow}}{{bold}}2 Pending{{/}} | {{cyan}}{{bold}}3 Skipped{{/}}",
+			"{{red}}{{bold}}Ran 10 of 14 Specs in 60.000 seconds{{/}}",
+			"{{red}}{{bold}}FAIL!{{/}} -- {{green}}{{bold}}5 Passed{{/}} | {{red}}{{bold}}5 Failed{{/}} | {{light-yellow}}{{bold}}2 Flaked{{/}} | {{yellow}}{{bold}}2 Pending{{/}} | {{cyan}}{{bold}}3 Skipped{{/}}",
 			"",
 		),
 		Entry("the suite fails with failed suite setups",
@@ -103,11 +103,11 @@ func ReportViaDeprecatedReporter(reporter DeprecatedReporter, report types.Repor
 				summary.NumberOfPendingSpecs += 1
 			case types.SpecStateSkipped:
 				summary.NumberOfSkippedSpecs += 1
-			case types.SpecStateFailed, types.SpecStatePanicked, types.SpecStateInterrupted:
+			case types.SpecStateFailed, types.SpecStateTimedout, types.SpecStatePanicked, types.SpecStateInterrupted:
 				summary.NumberOfFailedSpecs += 1
 			case types.SpecStatePassed:
 				summary.NumberOfPassedSpecs += 1
-				if spec.NumAttempts > 1 {
+				if spec.NumAttempts > 1 && spec.MaxMustPassRepeatedly == 0 {
 					summary.NumberOfFlakedSpecs += 1
 				}
 			}
@@ -58,3 +58,41 @@ func MergeAndCleanupJSONReports(sources []string, destination string) ([]string,
 	}
 	return messages, f.Close()
 }
+
+//MergeShardedJSONReports produces a single JSON-formatted report at the passed in destination by merging the JSON-formatted reports generated by each shard of a sharded test run
+//Reports for the same suite are matched by their SuiteDescription and combined with Report.MergeShard
+func MergeShardedJSONReports(sources []string, destination string) error {
+	mergedReports := []types.Report{}
+	indexBySuite := map[string]int{}
+	for _, source := range sources {
+		reports := []types.Report{}
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return err
+		}
+		err = json.Unmarshal(data, &reports)
+		if err != nil {
+			return fmt.Errorf("Could not decode %s:\n%s", source, err.Error())
+		}
+		for _, report := range reports {
+			if idx, ok := indexBySuite[report.SuiteDescription]; ok {
+				mergedReports[idx] = mergedReports[idx].MergeShard(report)
+			} else {
+				indexBySuite[report.SuiteDescription] = len(mergedReports)
+				mergedReports = append(mergedReports, report)
+			}
+		}
+	}
+
+	f, err := os.Create(destination)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	err = enc.Encode(mergedReports)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -29,7 +29,7 @@ type JUnitTestSuites struct {
 	Disabled int `xml:"disabled,attr"`
 	// Errors maps onto specs that panicked or were interrupted
 	Errors int `xml:"errors,attr"`
-	// Failures maps onto specs that failed
+	// Failures maps onto specs that failed or timed out
 	Failures int `xml:"failures,attr"`
 	// Time is the time in seconds to execute all test suites
 	Time float64 `xml:"time,attr"`
@@ -51,7 +51,7 @@ type JUnitTestSuite struct {
 	Skipped int `xml:"skipped,attr"`
 	// Errors maps onto specs that panicked or were interrupted
 	Errors int `xml:"errors,attr"`
-	// Failures maps onto specs that failed
+	// Failures maps onto specs that failed or timed out
 	Failures int `xml:"failures,attr"`
 	// Time is the time in seconds to execute all the test suite - maps onto Report.RunTime
 	Time float64 `xml:"time,attr"`
@@ -88,6 +88,8 @@ type JUnitTestCase struct {
 	Name string `xml:"name,attr"`
 	// Classname maps onto the name of the test suite - equivalent to Report.SuiteDescription
 	Classname string `xml:"classname,attr"`
+	// ID maps onto the stable ID of the spec - equivalent to SpecReport.SpecID
+	ID string `xml:"id,attr,omitempty"`
 	// Status maps onto the string representation of SpecReport.State
 	Status string `xml:"status,attr"`
 	// Time is the time in seconds to execute the spec - maps onto SpecReport.RunTime
@@ -96,7 +98,7 @@ type JUnitTestCase struct {
 	Skipped *JUnitSkipped `xml:"skipped,omitempty"`
 	//Error is populated if the test panicked or was interrupted
 	Error *JUnitError `xml:"error,omitempty"`
-	//Failure is populated if the test failed
+	//Failure is populated if the test failed or timed out
 	Failure *JUnitFailure `xml:"failure,omitempty"`
 	//SystemOut maps onto any captured stdout/stderr output - maps onto SpecReport.CapturedStdOutErr
 	SystemOut string `xml:"system-out,omitempty"`
@@ -121,7 +123,7 @@ type JUnitError struct {
 type JUnitFailure struct {
 	//Message maps onto the failure message - equivalent to SpecReport.Failure.Message
 	Message string `xml:"message,attr"`
-	//Type is "failed"
+	//Type is "failed", "timedout", or "aborted"
 	Type string `xml:"type,attr"`
 	//Description maps onto the location and stack trace of the failure
 	Description string `xml:",chardata"`
@@ -148,6 +150,7 @@ func GenerateJUnitReport(report types.Report, dst string) error {
 				{"FailOnPending", fmt.Sprintf("%t", report.SuiteConfig.FailOnPending)},
 				{"FailFast", fmt.Sprintf("%t", report.SuiteConfig.FailFast)},
 				{"FlakeAttempts", fmt.Sprintf("%d", report.SuiteConfig.FlakeAttempts)},
+				{"MustPassRepeatedly", fmt.Sprintf("%d", report.SuiteConfig.MustPassRepeatedly)},
 				{"EmitSpecProgress", fmt.Sprintf("%t", report.SuiteConfig.EmitSpecProgress)},
 				{"DryRun", fmt.Sprintf("%t", report.SuiteConfig.DryRun)},
 				{"ParallelTotal", fmt.Sprintf("%d", report.SuiteConfig.ParallelTotal)},
@@ -168,6 +171,7 @@ func GenerateJUnitReport(report types.Report, dst string) error {
 		test := JUnitTestCase{
 			Name:      name,
 			Classname: report.SuiteDescription,
+			ID:        spec.SpecID,
 			Status:    spec.State.String(),
 			Time:      spec.RunTime.Seconds(),
 			SystemOut: systemOutForUnstructureReporters(spec),
@@ -193,6 +197,13 @@ func GenerateJUnitReport(report types.Report, dst string) error {
 				Description: fmt.Sprintf("%s\n%s", spec.Failure.Location.String(), spec.Failure.Location.FullStackTrace),
 			}
 			suite.Failures += 1
+		case types.SpecStateTimedout:
+			test.Failure = &JUnitFailure{
+				Message:     spec.Failure.Message,
+				Type:        "timedout",
+				Description: fmt.Sprintf("%s\n%s", spec.Failure.Location.String(), spec.Failure.Location.FullStackTrace),
+			}
+			suite.Failures += 1
 		case types.SpecStateInterrupted:
 			test.Error = &JUnitError{
 				Message:     "interrupted",
@@ -291,6 +302,15 @@ func systemOutForUnstructureReporters(spec types.SpecReport) string {
 			}
 		}
 	}
+	if len(spec.Steps) > 0 {
+		systemOut += "\nStep Timeline:\n"
+		for _, step := range spec.Steps {
+			systemOut += fmt.Sprintf("%sSTEP: %s [%s] - %s @ %s\n", strings.Repeat("  ", step.NestingLevel), step.Text, step.Duration, step.CodeLocation, step.StartTime.Format(time.RFC3339Nano))
+		}
+	}
+	if spec.Failure.FailureStepText != "" {
+		systemOut += fmt.Sprintf("\nFailed during step: %s\n%s\n", spec.Failure.FailureStepText, spec.Failure.FailureStepLocation)
+	}
 	return systemOut
 }
 
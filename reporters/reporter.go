@@ -11,6 +11,20 @@ type Reporter interface {
 	SuiteDidEnd(report types.Report)
 }
 
+// ProgressReporter is an optional extension of Reporter.  Reporters that implement it are handed each progress report
+// Ginkgo generates - whether requested via a signal or emitted periodically by --poll-progress-after.
+type ProgressReporter interface {
+	EmitProgressReport(progressReport types.ProgressReport)
+}
+
+// NodeReporter is an optional extension of Reporter.  Reporters that implement it are informed each time an individual
+// node (setup, subject, cleanup, or reporting node) is about to run and has run.  report is the SpecReport for the spec
+// that the node belongs to (or for the suite-level node itself) at the time the event was emitted.
+type NodeReporter interface {
+	NodeWillRun(report types.SpecReport, event types.NodeEvent)
+	NodeDidRun(report types.SpecReport, event types.NodeEvent)
+}
+
 type NoopReporter struct{}
 
 func (n NoopReporter) SuiteWillBegin(report types.Report) {}
@@ -94,6 +94,22 @@ func ReportAfterEach(body func(SpecReport)) bool {
 	return pushNode(internal.NewReportAfterEachNode(body, types.NewCodeLocation(1)))
 }
 
+/*
+ReportBeforeSuite nodes are run at the beginning of the suite, before any BeforeSuite or SynchronizedBeforeSuite nodes and before any specs run.  ReportBeforeSuite nodes take a function that receives a suite Report.
+
+The Report's SpecReports include an entry for every spec in the suite - complete with the spec's container hierarchy, labels, and locations.  Specs that will be skipped have State SpecStateSkipped,
+pending specs have State SpecStatePending, and specs that will run have State SpecStateInvalid as they have not run yet.
+ReportBeforeSuite nodes must be created at the top-level (i.e. not nested in a Context/Describe/When node)
+
+When running in parallel, Ginkgo ensures that only parallel process #1 runs the ReportBeforeSuite nodes.
+
+You cannot nest any other Ginkgo nodes within a ReportBeforeSuite node's closure.
+You can learn more about ReportBeforeSuite here: https://onsi.github.io/ginkgo/#generating-reports-programmatically
+*/
+func ReportBeforeSuite(body func(Report)) bool {
+	return pushNode(internal.NewReportBeforeSuiteNode(body, types.NewCodeLocation(1)))
+}
+
 /*
 ReportAfterSuite nodes are run at the end of the suite.  ReportAfterSuite nodes take a function that receives a suite Report.
 
@@ -145,9 +161,11 @@ func registerReportAfterSuiteNodeForAutogeneratedReports(reporterConfig types.Re
 	if reporterConfig.TeamcityReport != "" {
 		flags = append(flags, "--teamcity-report")
 	}
-	pushNode(internal.NewReportAfterSuiteNode(
+	node, errors := internal.NewReportAfterSuiteNode(
 		fmt.Sprintf("Autogenerated ReportAfterSuite for %s", strings.Join(flags, " ")),
 		body,
 		types.NewCustomCodeLocation("autogenerated by Ginkgo"),
-	))
+	)
+	node.IsAutogeneratedReport = true
+	pushNode(node, errors)
 }
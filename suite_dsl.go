@@ -0,0 +1,187 @@
+package ginkgo
+
+import (
+	"github.com/onsi-experimental/ginkgo/v2/internal"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+/*
+Suite is an independent Ginkgo suite.  You generally construct one with NewSuite().
+
+Most packages only need one suite and should simply use Ginkgo's package-level DSL (Describe, It, BeforeSuite, RunSpecs, etc.) - which operates on a default Suite.
+A Suite allows a single package to host several independent suites, each run by its own TestX function with its own configuration.  For example:
+
+	var unitSuite = NewSuite()
+	var integrationSuite = NewSuite()
+
+	var _ = unitSuite.Describe("parsing", func() {
+		It("parses", func() { ... })
+	})
+
+	var _ = integrationSuite.Describe("the database", func() {
+		BeforeEach(func() { ... })
+		It("connects", func() { ... })
+	})
+
+	func TestUnit(t *testing.T) {
+		RegisterFailHandler(Fail)
+		unitSuite.RunSpecs(t, "Unit Suite")
+	}
+
+	func TestIntegration(t *testing.T) {
+		RegisterFailHandler(Fail)
+		suiteConfig, reporterConfig := GinkgoConfiguration()
+		suiteConfig.Timeout = time.Hour
+		integrationSuite.RunSpecs(t, "Integration Suite", suiteConfig, reporterConfig)
+	}
+
+Top-level nodes must be registered with the Suite's methods.  While a Suite is running, the package-level DSL operates on that Suite - so the
+nodes nested within its containers, as well as Fail, Skip, DeferCleanup, AddReportEntry, CurrentSpecReport, etc., can all be called as usual.
+
+You can learn more about hosting multiple suites in one package here: https://onsi.github.io/ginkgo/#running-multiple-suites-in-one-package
+*/
+type Suite struct {
+	suite  *internal.Suite
+	failer *internal.Failer
+	didRun bool
+}
+
+/*
+NewSuite returns a new, independent, Suite.  Nodes registered with the Suite's methods are only run when the Suite's RunSpecs is called.
+*/
+func NewSuite() *Suite {
+	return &Suite{
+		suite:  internal.NewSuite(),
+		failer: internal.NewFailer(),
+	}
+}
+
+/*
+RunSpecs runs the Suite.  It behaves just like the package-level RunSpecs (and accepts the same configuration overrides) but only runs the specs registered with this Suite.
+
+As with the package-level RunSpecs, each Suite can only be run once.
+*/
+func (s *Suite) RunSpecs(t GinkgoTestingT, description string, configs ...interface{}) bool {
+	if s.didRun {
+		exitIfErr(types.GinkgoErrors.RerunningSuite())
+	}
+	s.didRun = true
+
+	return runSpecs(t, description, s.suite, s.failer, configs...)
+}
+
+func (s *Suite) pushNode(node internal.Node, errors []error) bool {
+	exitIfErrors(errors)
+	if len(errors) == 0 {
+		exitIfErr(s.suite.PushNode(node))
+	}
+	return true
+}
+
+// Describe registers a container node with the Suite.  See the package-level Describe for details.
+func (s *Suite) Describe(text string, args ...interface{}) bool {
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeContainer, text, args...))
+}
+
+// FDescribe registers a focused container node with the Suite.  See the package-level FDescribe for details.
+func (s *Suite) FDescribe(text string, args ...interface{}) bool {
+	args = append(args, internal.Focus)
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeContainer, text, args...))
+}
+
+// PDescribe registers a pending container node with the Suite.  See the package-level PDescribe for details.
+func (s *Suite) PDescribe(text string, args ...interface{}) bool {
+	args = append(args, internal.Pending)
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeContainer, text, args...))
+}
+
+// Context is an alias for Describe
+func (s *Suite) Context(text string, args ...interface{}) bool {
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeContainer, text, args...))
+}
+
+// When is an alias for Describe
+func (s *Suite) When(text string, args ...interface{}) bool {
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeContainer, text, args...))
+}
+
+// It registers a subject node with the Suite.  See the package-level It for details.
+func (s *Suite) It(text string, args ...interface{}) bool {
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeIt, text, args...))
+}
+
+// FIt registers a focused subject node with the Suite.  See the package-level FIt for details.
+func (s *Suite) FIt(text string, args ...interface{}) bool {
+	args = append(args, internal.Focus)
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeIt, text, args...))
+}
+
+// PIt registers a pending subject node with the Suite.  See the package-level PIt for details.
+func (s *Suite) PIt(text string, args ...interface{}) bool {
+	args = append(args, internal.Pending)
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeIt, text, args...))
+}
+
+// BeforeSuite registers a BeforeSuite node with the Suite.  See the package-level BeforeSuite for details.
+func (s *Suite) BeforeSuite(body interface{}, args ...interface{}) bool {
+	combinedArgs := []interface{}{body}
+	combinedArgs = append(combinedArgs, args...)
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeBeforeSuite, "", combinedArgs...))
+}
+
+// AfterSuite registers an AfterSuite node with the Suite.  See the package-level AfterSuite for details.
+func (s *Suite) AfterSuite(body interface{}, args ...interface{}) bool {
+	combinedArgs := []interface{}{body}
+	combinedArgs = append(combinedArgs, args...)
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeAfterSuite, "", combinedArgs...))
+}
+
+// SynchronizedBeforeSuite registers a SynchronizedBeforeSuite node with the Suite.  See the package-level SynchronizedBeforeSuite for details.
+func (s *Suite) SynchronizedBeforeSuite(process1Body func() []byte, allProcessBody func([]byte)) bool {
+	return s.pushNode(internal.NewSynchronizedBeforeSuiteNode(process1Body, allProcessBody, types.NewCodeLocation(1)))
+}
+
+// SynchronizedAfterSuite registers a SynchronizedAfterSuite node with the Suite.  See the package-level SynchronizedAfterSuite for details.
+func (s *Suite) SynchronizedAfterSuite(allProcessBody func(), process1Body func()) bool {
+	return s.pushNode(internal.NewSynchronizedAfterSuiteNode(allProcessBody, process1Body, types.NewCodeLocation(1)))
+}
+
+// BeforeEach registers a top-level BeforeEach node with the Suite.  See the package-level BeforeEach for details.
+func (s *Suite) BeforeEach(args ...interface{}) bool {
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeBeforeEach, "", args...))
+}
+
+// JustBeforeEach registers a top-level JustBeforeEach node with the Suite.  See the package-level JustBeforeEach for details.
+func (s *Suite) JustBeforeEach(args ...interface{}) bool {
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeJustBeforeEach, "", args...))
+}
+
+// AfterEach registers a top-level AfterEach node with the Suite.  See the package-level AfterEach for details.
+func (s *Suite) AfterEach(args ...interface{}) bool {
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeAfterEach, "", args...))
+}
+
+// JustAfterEach registers a top-level JustAfterEach node with the Suite.  See the package-level JustAfterEach for details.
+func (s *Suite) JustAfterEach(args ...interface{}) bool {
+	return s.pushNode(internal.NewNode(deprecationTracker, types.NodeTypeJustAfterEach, "", args...))
+}
+
+// ReportBeforeEach registers a top-level ReportBeforeEach node with the Suite.  See the package-level ReportBeforeEach for details.
+func (s *Suite) ReportBeforeEach(body func(SpecReport)) bool {
+	return s.pushNode(internal.NewReportBeforeEachNode(body, types.NewCodeLocation(1)))
+}
+
+// ReportAfterEach registers a top-level ReportAfterEach node with the Suite.  See the package-level ReportAfterEach for details.
+func (s *Suite) ReportAfterEach(body func(SpecReport)) bool {
+	return s.pushNode(internal.NewReportAfterEachNode(body, types.NewCodeLocation(1)))
+}
+
+// ReportBeforeSuite registers a ReportBeforeSuite node with the Suite.  See the package-level ReportBeforeSuite for details.
+func (s *Suite) ReportBeforeSuite(body func(Report)) bool {
+	return s.pushNode(internal.NewReportBeforeSuiteNode(body, types.NewCodeLocation(1)))
+}
+
+// ReportAfterSuite registers a ReportAfterSuite node with the Suite.  See the package-level ReportAfterSuite for details.
+func (s *Suite) ReportAfterSuite(text string, body func(Report)) bool {
+	return s.pushNode(internal.NewReportAfterSuiteNode(text, body, types.NewCodeLocation(1)))
+}
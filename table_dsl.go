@@ -44,7 +44,7 @@ You can learn more about DescribeTable here: https://onsi.github.io/ginkgo/#tabl
 And can explore some Table patterns here: https://onsi.github.io/ginkgo/#table-specs-patterns
 */
 func DescribeTable(description string, args ...interface{}) bool {
-	generateTable(description, args...)
+	generateTable(description, false, args...)
 	return true
 }
 
@@ -53,7 +53,7 @@ You can focus a table with `FDescribeTable`.  This is equivalent to `FDescribe`.
 */
 func FDescribeTable(description string, args ...interface{}) bool {
 	args = append(args, internal.Focus)
-	generateTable(description, args...)
+	generateTable(description, false, args...)
 	return true
 }
 
@@ -62,7 +62,7 @@ You can mark a table as pending with `PDescribeTable`.  This is equivalent to `P
 */
 func PDescribeTable(description string, args ...interface{}) bool {
 	args = append(args, internal.Pending)
-	generateTable(description, args...)
+	generateTable(description, false, args...)
 	return true
 }
 
@@ -71,6 +71,70 @@ You can mark a table as pending with `XDescribeTable`.  This is equivalent to `X
 */
 var XDescribeTable = PDescribeTable
 
+/*
+DescribeTableSubtree describes a table-driven spec that generates a set of tests for each entry.
+
+For example:
+
+    DescribeTableSubtree("a subtree table",
+        func(url string, code int, message string) {
+            var resp *http.Response
+            BeforeEach(func() {
+                var err error
+                resp, err = http.Get(url)
+                Expect(err).NotTo(HaveOccurred())
+                DeferCleanup(resp.Body.Close)
+            })
+
+            It("should return the expected status code", func() {
+                Expect(resp.StatusCode).To(Equal(code))
+            })
+
+            It("should return the expected message", func() {
+                body, err := io.ReadAll(resp.Body)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(string(body)).To(Equal(message))
+            })
+        },
+        Entry("default response", "example.com/response", http.StatusOK, "hello world"),
+        Entry("missing response", "example.com/missing", http.StatusNotFound, "wat?"),
+    )
+
+Note that you **must** define an It inside the body function.
+
+Each Entry ends up generating a Ginkgo container node whose body is the Table Body function with the Entry parameters passed in.  Any decorators on the Entry (e.g. Focus, Pending, Label) are applied to the generated container.
+
+You can learn more about DescribeTableSubtree here: https://onsi.github.io/ginkgo/#table-specs
+And can explore some Table patterns here: https://onsi.github.io/ginkgo/#table-specs-patterns
+*/
+func DescribeTableSubtree(description string, args ...interface{}) bool {
+	generateTable(description, true, args...)
+	return true
+}
+
+/*
+You can focus a table with `FDescribeTableSubtree`.  This is equivalent to `FDescribe`.
+*/
+func FDescribeTableSubtree(description string, args ...interface{}) bool {
+	args = append(args, internal.Focus)
+	generateTable(description, true, args...)
+	return true
+}
+
+/*
+You can mark a table as pending with `PDescribeTableSubtree`.  This is equivalent to `PDescribe`.
+*/
+func PDescribeTableSubtree(description string, args ...interface{}) bool {
+	args = append(args, internal.Pending)
+	generateTable(description, true, args...)
+	return true
+}
+
+/*
+You can mark a table as pending with `XDescribeTableSubtree`.  This is equivalent to `XDescribe`.
+*/
+var XDescribeTableSubtree = PDescribeTableSubtree
+
 /*
 TableEntry represents an entry in a table test.  You generally use the `Entry` constructor.
 */
@@ -88,6 +152,7 @@ The first argument is a description.  This can be a string, a function that acce
 Subsequent arguments accept any Ginkgo decorators.  These are filtered out and the remaining arguments are passed into the Spec function associated with the table.
 
 Each Entry ends up generating an individual Ginkgo It.  The body of the it is the Table Body function with the Entry parameters passed in.
+When used with DescribeTableSubtree each Entry generates a Ginkgo container instead.  The body of the container is the Table Body function with the Entry parameters passed in.
 
 You can learn more about Entry here: https://onsi.github.io/ginkgo/#table-specs
 */
@@ -119,12 +184,12 @@ You can mark a particular entry as pending with XEntry.  This is equivalent to X
 */
 var XEntry = PEntry
 
-func generateTable(description string, args ...interface{}) {
+func generateTable(description string, isSubtree bool, args ...interface{}) {
 	cl := types.NewCodeLocation(2)
 	containerNodeArgs := []interface{}{cl}
 
 	entries := []TableEntry{}
-	var itBody interface{}
+	var internalBody interface{}
 
 	var tableLevelEntryDescription interface{}
 	tableLevelEntryDescription = func(args ...interface{}) string {
@@ -146,10 +211,10 @@ func generateTable(description string, args ...interface{}) {
 		case t.Kind() == reflect.Func && t.NumOut() == 1 && t.Out(0) == reflect.TypeOf(""):
 			tableLevelEntryDescription = arg
 		case t.Kind() == reflect.Func:
-			if itBody != nil {
+			if internalBody != nil {
 				exitIfErr(types.GinkgoErrors.MultipleEntryBodyFunctionsForTable(cl))
 			}
-			itBody = arg
+			internalBody = arg
 		default:
 			containerNodeArgs = append(containerNodeArgs, arg)
 		}
@@ -180,18 +245,25 @@ func generateTable(description string, args ...interface{}) {
 			}
 
 			if err == nil {
-				err = validateParameters(itBody, entry.parameters, "Table Body function", entry.codeLocation)
+				err = validateParameters(internalBody, entry.parameters, "Table Body function", entry.codeLocation)
+			}
+
+			internalNodeType := types.NodeTypeIt
+			if isSubtree {
+				internalNodeType = types.NodeTypeContainer
+				exitIfErr(err)
 			}
-			itNodeArgs := []interface{}{entry.codeLocation}
-			itNodeArgs = append(itNodeArgs, entry.decorations...)
-			itNodeArgs = append(itNodeArgs, func() {
+
+			internalNodeArgs := []interface{}{entry.codeLocation}
+			internalNodeArgs = append(internalNodeArgs, entry.decorations...)
+			internalNodeArgs = append(internalNodeArgs, func() {
 				if err != nil {
 					panic(err)
 				}
-				invokeFunction(itBody, entry.parameters)
+				invokeFunction(internalBody, entry.parameters)
 			})
 
-			pushNode(internal.NewNode(deprecationTracker, types.NodeTypeIt, description, itNodeArgs...))
+			pushNode(internal.NewNode(deprecationTracker, internalNodeType, description, internalNodeArgs...))
 		}
 	})
 
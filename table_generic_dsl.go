@@ -0,0 +1,152 @@
+package ginkgo
+
+import (
+	"fmt"
+
+	"github.com/onsi-experimental/ginkgo/v2/internal"
+	"github.com/onsi-experimental/ginkgo/v2/types"
+)
+
+/*
+DescribeTableOf describes a type-safe table-driven spec.  Each entry's parameters are a single value of type T, and the table body must accept a T.
+
+For example:
+
+    type additionCase struct {
+        a, b, expected int
+    }
+
+    DescribeTableOf("addition",
+        func(c additionCase) {
+            Ω(c.a + c.b).Should(Equal(c.expected))
+        },
+        EntryOf("1+2=3", additionCase{1, 2, 3}),
+        EntryOf("-1+2=1", additionCase{-1, 2, 1}),
+        EntryOf(func(c additionCase) string { return fmt.Sprintf("%d+%d", c.a, c.b) }, additionCase{0, 0, 0}),
+    )
+
+Unlike DescribeTable, the compiler checks that every entry's parameters match the table body - so mismatched entries fail to compile instead of failing at runtime.
+DescribeTableOf generates the same container and It nodes as DescribeTable and the two can be freely mixed within a suite.
+
+To decorate the entire table, wrap it in a decorated container node.
+
+You can learn more about DescribeTableOf here: https://onsi.github.io/ginkgo/#type-safe-tables
+*/
+func DescribeTableOf[T any](description string, body func(T), entries ...TableEntryOf[T]) bool {
+	generateTableOf(description, body, entries)
+	return true
+}
+
+/*
+You can focus a type-safe table with `FDescribeTableOf`.  This is equivalent to `FDescribe`.
+*/
+func FDescribeTableOf[T any](description string, body func(T), entries ...TableEntryOf[T]) bool {
+	generateTableOf(description, body, entries, internal.Focus)
+	return true
+}
+
+/*
+You can mark a type-safe table as pending with `PDescribeTableOf`.  This is equivalent to `PDescribe`.
+*/
+func PDescribeTableOf[T any](description string, body func(T), entries ...TableEntryOf[T]) bool {
+	generateTableOf(description, body, entries, internal.Pending)
+	return true
+}
+
+/*
+You can mark a type-safe table as pending with `XDescribeTableOf`.  This is equivalent to `XDescribe`.
+*/
+func XDescribeTableOf[T any](description string, body func(T), entries ...TableEntryOf[T]) bool {
+	generateTableOf(description, body, entries, internal.Pending)
+	return true
+}
+
+/*
+TableEntryOf represents an entry in a type-safe table test.  You generally use the `EntryOf` constructor.
+*/
+type TableEntryOf[T any] struct {
+	description  interface{}
+	decorations  []interface{}
+	parameters   T
+	codeLocation types.CodeLocation
+}
+
+/*
+EntryOf constructs a TableEntryOf.
+
+The first argument is a description.  This can be a string, a function that accepts a T and returns a string, an EntryDescription format string, or nil.
+If nil is provided then the name of the Entry is derived from the entry's parameters.
+The second argument holds the parameters passed to the table body.  Subsequent arguments accept any Ginkgo decorators.
+
+Each EntryOf ends up generating an individual Ginkgo It.  The body of the it is the table body function with the entry's parameters passed in.
+
+You can learn more about EntryOf here: https://onsi.github.io/ginkgo/#type-safe-tables
+*/
+func EntryOf[T any](description interface{}, parameters T, decorations ...interface{}) TableEntryOf[T] {
+	return TableEntryOf[T]{description: description, decorations: decorations, parameters: parameters, codeLocation: types.NewCodeLocation(1)}
+}
+
+/*
+You can focus a particular entry with FEntryOf.  This is equivalent to FIt.
+*/
+func FEntryOf[T any](description interface{}, parameters T, decorations ...interface{}) TableEntryOf[T] {
+	decorations = append(decorations, internal.Focus)
+	return TableEntryOf[T]{description: description, decorations: decorations, parameters: parameters, codeLocation: types.NewCodeLocation(1)}
+}
+
+/*
+You can mark a particular entry as pending with PEntryOf.  This is equivalent to PIt.
+*/
+func PEntryOf[T any](description interface{}, parameters T, decorations ...interface{}) TableEntryOf[T] {
+	decorations = append(decorations, internal.Pending)
+	return TableEntryOf[T]{description: description, decorations: decorations, parameters: parameters, codeLocation: types.NewCodeLocation(1)}
+}
+
+/*
+You can mark a particular entry as pending with XEntryOf.  This is equivalent to XIt.
+*/
+func XEntryOf[T any](description interface{}, parameters T, decorations ...interface{}) TableEntryOf[T] {
+	decorations = append(decorations, internal.Pending)
+	return TableEntryOf[T]{description: description, decorations: decorations, parameters: parameters, codeLocation: types.NewCodeLocation(1)}
+}
+
+func (entry TableEntryOf[T]) render() (string, error) {
+	switch description := entry.description.(type) {
+	case nil:
+		return fmt.Sprintf("Entry: %v", entry.parameters), nil
+	case string:
+		return description, nil
+	case EntryDescription:
+		return description.render(entry.parameters), nil
+	case func(T) string:
+		return description(entry.parameters), nil
+	default:
+		return "", types.GinkgoErrors.InvalidEntryDescription(entry.codeLocation)
+	}
+}
+
+func generateTableOf[T any](description string, body func(T), entries []TableEntryOf[T], decorations ...interface{}) {
+	cl := types.NewCodeLocation(2)
+	containerNodeArgs := []interface{}{cl}
+	containerNodeArgs = append(containerNodeArgs, decorations...)
+
+	containerNodeArgs = append(containerNodeArgs, func() {
+		for _, entry := range entries {
+			entry := entry
+			description, err := entry.render()
+
+			itNodeArgs := []interface{}{entry.codeLocation}
+			itNodeArgs = append(itNodeArgs, entry.decorations...)
+			itNodeArgs = append(itNodeArgs, func() {
+				if err != nil {
+					panic(err)
+				}
+				body(entry.parameters)
+			})
+
+			pushNode(internal.NewNode(deprecationTracker, types.NodeTypeIt, description, itNodeArgs...))
+		}
+	})
+
+	pushNode(internal.NewNode(deprecationTracker, types.NodeTypeContainer, description, containerNodeArgs...))
+}
@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"runtime/debug"
 	"strings"
+	"sync"
 )
 
 type CodeLocation struct {
@@ -45,16 +46,71 @@ func NewCustomCodeLocation(message string) CodeLocation {
 }
 
 func NewCodeLocation(skip int) CodeLocation {
-	_, file, line, _ := runtime.Caller(skip + 1)
+	file, line, _ := callerSkippingHelpers(skip)
 	return CodeLocation{FileName: file, LineNumber: line}
 }
 
 func NewCodeLocationWithStackTrace(skip int) CodeLocation {
-	_, file, line, _ := runtime.Caller(skip + 1)
-	stackTrace := PruneStack(string(debug.Stack()), skip+1)
+	file, line, skippedHelpers := callerSkippingHelpers(skip)
+	stackTrace := PruneStack(string(debug.Stack()), skip+1+skippedHelpers)
 	return CodeLocation{FileName: file, LineNumber: line, FullStackTrace: stackTrace}
 }
 
+var helperFunctions = map[string]bool{}
+var helperFunctionsLock = &sync.RWMutex{}
+
+/*
+MarkAsHelper marks the function that calls it as a helper.  Frames belonging to helper functions are skipped when computing
+a CodeLocation - the location of the first non-helper caller is used instead.  This mirrors the behavior of testing.T's Helper.
+
+Pass in optionalSkip to mark a function further up the stack.
+*/
+func MarkAsHelper(optionalSkip ...int) {
+	skip := 1
+	if len(optionalSkip) > 0 {
+		skip += optionalSkip[0]
+	}
+	pcs := make([]uintptr, 1)
+	if runtime.Callers(skip+1, pcs) == 0 {
+		return
+	}
+	frame, _ := runtime.CallersFrames(pcs).Next()
+	helperFunctionsLock.Lock()
+	helperFunctions[frame.Function] = true
+	helperFunctionsLock.Unlock()
+}
+
+// callerSkippingHelpers behaves like runtime.Caller(skip + 1) when called from NewCodeLocation or NewCodeLocationWithStackTrace
+// but moves past any frames belonging to helper functions.  It also returns the number of frames that were skipped.
+func callerSkippingHelpers(skip int) (string, int, int) {
+	helperFunctionsLock.RLock()
+	defer helperFunctionsLock.RUnlock()
+	if len(helperFunctions) == 0 {
+		_, file, line, _ := runtime.Caller(skip + 2)
+		return file, line, 0
+	}
+
+	pcs := make([]uintptr, 100)
+	n := runtime.Callers(skip+3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	var first runtime.Frame
+	skippedHelpers := 0
+	for {
+		frame, more := frames.Next()
+		if skippedHelpers == 0 {
+			first = frame
+		}
+		if !helperFunctions[frame.Function] {
+			return frame.File, frame.Line, skippedHelpers
+		}
+		if !more {
+			break
+		}
+		skippedHelpers += 1
+	}
+	return first.File, first.Line, 0
+}
+
 // PruneStack removes references to functions that are internal to Ginkgo
 // and the Go runtime from a stack string and a certain number of stack entries
 // at the beginning of the stack. The stack string has the format
@@ -69,6 +69,55 @@ var _ = Describe("CodeLocation", func() {
 		})
 	})
 
+	Describe("skipping helper functions", func() {
+		var helperLocation, helperLocationWithStackTrace types.CodeLocation
+		var expectedLineNumber int
+
+		innerHelper := func() {
+			types.MarkAsHelper()
+			helperLocation = types.NewCodeLocation(0)
+			helperLocationWithStackTrace = types.NewCodeLocationWithStackTrace(0)
+		}
+
+		outerHelper := func() {
+			types.MarkAsHelper()
+			innerHelper()
+		}
+
+		BeforeEach(func() {
+			_, _, expectedLineNumber, _ = runtime.Caller(0)
+			expectedLineNumber += 2
+			outerHelper()
+		})
+
+		It("reports the location of the first caller that is not a helper", func() {
+			Ω(helperLocation.FileName).Should(HaveSuffix("code_location_test.go"))
+			Ω(helperLocation.LineNumber).Should(Equal(expectedLineNumber))
+			Ω(helperLocationWithStackTrace.LineNumber).Should(Equal(expectedLineNumber))
+		})
+
+		It("prunes the helpers from the stack trace", func() {
+			Ω(helperLocationWithStackTrace.FullStackTrace).Should(HavePrefix("github.com/onsi-experimental/ginkgo/v2/types_test"))
+			Ω(helperLocationWithStackTrace.FullStackTrace).Should(ContainSubstring("code_location_test.go:%d", expectedLineNumber))
+			Ω(helperLocationWithStackTrace.FullStackTrace).ShouldNot(ContainSubstring("code_location_test.go:%d", expectedLineNumber-6), "outerHelper's call to innerHelper")
+			Ω(helperLocationWithStackTrace.FullStackTrace).ShouldNot(ContainSubstring("code_location_test.go:%d", expectedLineNumber-11), "innerHelper's call to NewCodeLocationWithStackTrace")
+		})
+
+		It("can mark functions further up the stack", func() {
+			var location types.CodeLocation
+			markCaller := func() {
+				types.MarkAsHelper(1)
+			}
+			helper := func() {
+				markCaller()
+				location = types.NewCodeLocation(0)
+			}
+			_, _, line, _ := runtime.Caller(0)
+			helper()
+			Ω(location.LineNumber).Should(Equal(line + 1))
+		})
+	})
+
 	Describe("PruneStack", func() {
 		It("should remove any references to ginkgo and pkg/testing and pkg/runtime", func() {
 			// Hard-coded string, loosely based on what debug.Stack() produces.
@@ -18,31 +18,58 @@ import (
 type SuiteConfig struct {
 	RandomSeed            int64
 	RandomizeAllSpecs     bool
+	OrderBy               string
 	FocusStrings          []string
 	SkipStrings           []string
 	FocusFiles            []string
 	SkipFiles             []string
+	FocusIDs              []string
+	SkipIDs               []string
 	LabelFilter           string
 	FailOnPending         bool
 	FailFast              bool
 	FlakeAttempts         int
+	MustPassRepeatedly    int
 	EmitSpecProgress      bool
 	DryRun                bool
 	Timeout               time.Duration
+	GracePeriod           time.Duration
+	PollProgressAfter     time.Duration
+	PollProgressInterval  time.Duration
 	OutputInterceptorMode string
 
-	ParallelProcess int
-	ParallelTotal   int
-	ParallelHost    string
+	ParallelMode       string
+	ParallelProcess    int
+	ParallelTotal      int
+	ParallelHost       string
+	ParallelGoroutines int
+
+	ShardIndex int
+	ShardTotal int
 }
 
 func NewDefaultSuiteConfig() SuiteConfig {
 	return SuiteConfig{
-		RandomSeed:      time.Now().Unix(),
-		Timeout:         time.Hour,
-		ParallelProcess: 1,
-		ParallelTotal:   1,
+		RandomSeed:           time.Now().Unix(),
+		Timeout:              time.Hour,
+		GracePeriod:          30 * time.Second,
+		PollProgressInterval: 10 * time.Second,
+		ParallelProcess:      1,
+		ParallelTotal:        1,
+		ShardIndex:           1,
+		ShardTotal:           1,
+	}
+}
+
+// ComputedParallelGoroutines returns the number of goroutines specs should be run across.  This is always 1 unless ParallelMode is goroutines.
+func (s SuiteConfig) ComputedParallelGoroutines() int {
+	if strings.ToLower(s.ParallelMode) != "goroutines" || s.DryRun {
+		return 1
+	}
+	if s.ParallelGoroutines > 0 {
+		return s.ParallelGoroutines
 	}
+	return runtime.NumCPU()
 }
 
 type VerbosityLevel uint
@@ -83,6 +110,7 @@ type ReporterConfig struct {
 	VeryVerbose            bool
 	FullTrace              bool
 	AlwaysEmitGinkgoWriter bool
+	GinkgoLogrVerbosity    int
 
 	JSONReport     string
 	JUnitReport    string
@@ -125,6 +153,7 @@ type CLIConfig struct {
 	OutputDir                 string
 	KeepSeparateCoverprofiles bool
 	KeepSeparateReports       bool
+	ScheduleFrom              string
 
 	//for run only
 	KeepGoing       bool
@@ -135,12 +164,16 @@ type CLIConfig struct {
 	//for watch only
 	Depth       int
 	WatchRegExp string
+
+	//for list only
+	ListFormat string
 }
 
 func NewDefaultCLIConfig() CLIConfig {
 	return CLIConfig{
 		Depth:       1,
 		WatchRegExp: `\.go$`,
+		ListFormat:  "text",
 	}
 }
 
@@ -260,6 +293,8 @@ var SuiteConfigFlags = GinkgoFlags{
 		Usage: "The seed used to randomize the spec suite."},
 	{KeyPath: "S.RandomizeAllSpecs", Name: "randomize-all", SectionKey: "order", DeprecatedName: "randomizeAllSpecs", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will randomize all specs together.  By default, ginkgo only randomizes the top level Describe, Context and When containers."},
+	{KeyPath: "S.OrderBy", Name: "order-by", SectionKey: "order", UsageArgument: "location or identity", UsageDefaultValue: "location",
+		Usage: "Controls what ginkgo keys the randomized order on.  By default ginkgo uses the code location of each spec, so edits that shift line numbers change the order produced by a given seed.  If set to identity, ginkgo uses each spec's texts and file name instead so that a given seed reproduces the same order across unrelated code changes."},
 
 	{KeyPath: "S.FailOnPending", Name: "fail-on-pending", SectionKey: "failure", DeprecatedName: "failOnPending", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will mark the test suite as failed if any specs are pending."},
@@ -267,6 +302,8 @@ var SuiteConfigFlags = GinkgoFlags{
 		Usage: "If set, ginkgo will stop running a test suite after a failure occurs."},
 	{KeyPath: "S.FlakeAttempts", Name: "flake-attempts", SectionKey: "failure", UsageDefaultValue: "0 - failed tests are not retried", DeprecatedName: "flakeAttempts", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "Make up to this many attempts to run each spec. If any of the attempts succeed, the suite will not be failed."},
+	{KeyPath: "S.MustPassRepeatedly", Name: "must-pass-repeatedly", SectionKey: "failure", UsageDefaultValue: "0 - specs are run once",
+		Usage: "Run each spec this many times.  The spec fails on the first failing attempt.  Useful for catching flaky specs before they merge."},
 
 	{KeyPath: "S.DryRun", Name: "dry-run", SectionKey: "debug", DeprecatedName: "dryRun", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will walk the test hierarchy without actually running anything.  Best paired with -v."},
@@ -274,6 +311,18 @@ var SuiteConfigFlags = GinkgoFlags{
 		Usage: "If set, ginkgo will emit progress information as each spec runs to the GinkgoWriter."},
 	{KeyPath: "S.Timeout", Name: "timeout", SectionKey: "debug", UsageDefaultValue: "1h",
 		Usage: "Test suite fails if it does not complete within the specified timeout."},
+	{KeyPath: "S.GracePeriod", Name: "grace-period", SectionKey: "debug", UsageDefaultValue: "30s",
+		Usage: "When a node that accepts a context is timed out or interrupted, Ginkgo cancels its context and waits up to grace-period for it to exit before abandoning it."},
+	{KeyPath: "S.PollProgressAfter", Name: "poll-progress-after", SectionKey: "debug", UsageDefaultValue: "0",
+		Usage: "Emit node progress reports periodically if node hasn't completed after this duration."},
+	{KeyPath: "S.PollProgressInterval", Name: "poll-progress-interval", SectionKey: "debug", UsageDefaultValue: "10s",
+		Usage: "The rate at which to emit node progress reports after poll-progress-after has elapsed."},
+	{KeyPath: "S.ParallelMode", Name: "parallel-mode", SectionKey: "parallel", UsageArgument: "processes or goroutines", UsageDefaultValue: "processes",
+		Usage: "When running in parallel, ginkgo will run specs across separate processes by default.  If set to goroutines, ginkgo will instead run specs concurrently on goroutines within a single process."},
+	{KeyPath: "S.ShardIndex", Name: "shard-index", SectionKey: "parallel", UsageDefaultValue: "1",
+		Usage: "This machine's (one-indexed) shard number.  When sharding a suite across machines, ginkgo will only run the specs assigned to this shard and will report all other specs as skipped."},
+	{KeyPath: "S.ShardTotal", Name: "shard-total", SectionKey: "parallel", UsageDefaultValue: "1 (no sharding)",
+		Usage: "The total number of shards to split the suite's specs across.  Every shard must be run with the same --seed and filters so that each spec is assigned to exactly one shard."},
 	{KeyPath: "S.OutputInterceptorMode", Name: "output-interceptor-mode", SectionKey: "debug", UsageArgument: "dup, swap, or none",
 		Usage: "If set, ginkgo will use the specified output interception strategy when running in parallel.  Defaults to dup on unix and swap on windows."},
 
@@ -287,6 +336,10 @@ var SuiteConfigFlags = GinkgoFlags{
 		Usage: "If set, ginkgo will only run specs in matching files. Can be specified multiple times, values are ORed."},
 	{KeyPath: "S.SkipFiles", Name: "skip-file", SectionKey: "filter", UsageArgument: "file (regexp) | file:line | file:lineA-lineB | file:line,line,line",
 		Usage: "If set, ginkgo will skip specs in matching files. Can be specified multiple times, values are ORed."},
+	{KeyPath: "S.FocusIDs", Name: "focus-id", SectionKey: "filter", UsageArgument: "spec id",
+		Usage: "If set, ginkgo will only run the spec with this ID.  Spec IDs are stable and are included in SpecReports and in Ginkgo's JSON and JUnit reports. Can be specified multiple times, values are ORed."},
+	{KeyPath: "S.SkipIDs", Name: "skip-id", SectionKey: "filter", UsageArgument: "spec id",
+		Usage: "If set, ginkgo will skip the spec with this ID. Can be specified multiple times, values are ORed."},
 
 	{KeyPath: "D.RegexScansFilePath", DeprecatedName: "regexScansFilePath", DeprecatedDocLink: "removed--regexscansfilepath", DeprecatedVersion: "2.0.0"},
 	{KeyPath: "D.DebugParallel", DeprecatedName: "debug", DeprecatedDocLink: "removed--debug", DeprecatedVersion: "2.0.0"},
@@ -300,6 +353,8 @@ var ParallelConfigFlags = GinkgoFlags{
 		Usage: "The total number of worker processes.  For running specs in parallel."},
 	{KeyPath: "S.ParallelHost", Name: "parallel.host", SectionKey: "low-level-parallel", UsageDefaultValue: "set by Ginkgo CLI",
 		Usage: "The address for the server that will synchronize the processes."},
+	{KeyPath: "S.ParallelGoroutines", Name: "parallel.goroutines", SectionKey: "low-level-parallel", UsageDefaultValue: "set by Ginkgo CLI, or the number of CPUs",
+		Usage: "The number of goroutines to run specs across when --parallel-mode=goroutines."},
 }
 
 // ReporterConfigFlags provides flags for the Ginkgo test process, and CLI
@@ -318,6 +373,8 @@ var ReporterConfigFlags = GinkgoFlags{
 		Usage: "If set, default reporter prints out the full stack trace when a failure occurs"},
 	{KeyPath: "R.AlwaysEmitGinkgoWriter", Name: "always-emit-ginkgo-writer", SectionKey: "output", DeprecatedName: "reportPassed", DeprecatedDocLink: "renamed--reportpassed",
 		Usage: "If set, default reporter prints out captured output of passed tests."},
+	{KeyPath: "R.GinkgoLogrVerbosity", Name: "ginkgo-logr-verbosity", SectionKey: "output", UsageArgument: "int", UsageDefaultValue: "0",
+		Usage: "GinkgoLogr only emits log lines whose V-level is less than or equal to this verbosity."},
 
 	{KeyPath: "R.JSONReport", Name: "json-report", UsageArgument: "filename.json", SectionKey: "output",
 		Usage: "If set, Ginkgo will generate a JSON-formatted test report at the specified location."},
@@ -377,8 +434,26 @@ func VetConfig(flagSet GinkgoFlagSet, suiteConfig SuiteConfig, reporterConfig Re
 		errors = append(errors, GinkgoErrors.MissingParallelHostConfiguration())
 	}
 
-	if suiteConfig.DryRun && suiteConfig.ParallelTotal > 1 {
-		errors = append(errors, GinkgoErrors.DryRunInParallelConfiguration())
+	errors = append(errors, VetShardConfig(flagSet, suiteConfig)...)
+
+	switch strings.ToLower(suiteConfig.ParallelMode) {
+	case "", "processes":
+	case "goroutines":
+		if suiteConfig.ParallelTotal > 1 {
+			errors = append(errors, GinkgoErrors.GoroutineParallelModeWithMultipleProcessesConfiguration())
+		}
+	default:
+		errors = append(errors, GinkgoErrors.InvalidParallelModeConfiguration(suiteConfig.ParallelMode))
+	}
+
+	switch strings.ToLower(suiteConfig.OrderBy) {
+	case "", "location", "identity":
+	default:
+		errors = append(errors, GinkgoErrors.InvalidOrderByConfiguration(suiteConfig.OrderBy))
+	}
+
+	if suiteConfig.FlakeAttempts > 0 && suiteConfig.MustPassRepeatedly > 0 {
+		errors = append(errors, GinkgoErrors.FlakeAttemptsAndMustPassRepeatedlyConfiguration())
 	}
 
 	if len(suiteConfig.FocusFiles) > 0 {
@@ -421,6 +496,20 @@ func VetConfig(flagSet GinkgoFlagSet, suiteConfig SuiteConfig, reporterConfig Re
 	return errors
 }
 
+// VetShardConfig validates --shard-index and --shard-total.  The Ginkgo CLI calls it directly as it always passes an explicit seed on to the test process.
+func VetShardConfig(flagSet GinkgoFlagSet, suiteConfig SuiteConfig) []error {
+	errors := []error{}
+	isSharded := suiteConfig.ShardTotal > 1 || suiteConfig.ShardIndex > 1
+	if suiteConfig.ShardTotal < 0 || suiteConfig.ShardIndex < 0 || (isSharded && (suiteConfig.ShardIndex < 1 || suiteConfig.ShardIndex > suiteConfig.ShardTotal)) {
+		errors = append(errors, GinkgoErrors.InvalidShardConfiguration(suiteConfig.ShardIndex, suiteConfig.ShardTotal))
+	}
+	// shards are assigned using the random seed so every shard must use the same seed
+	if suiteConfig.ShardTotal > 1 && !flagSet.WasSet("seed") && !flagSet.WasSet("ginkgo.seed") {
+		errors = append(errors, GinkgoErrors.ShardingWithoutSeedConfiguration())
+	}
+	return errors
+}
+
 // GinkgoCLISharedFlags provides flags shared by the Ginkgo CLI's build, watch, and run commands
 var GinkgoCLISharedFlags = GinkgoFlags{
 	{KeyPath: "C.Recurse", Name: "r", SectionKey: "multiple-suites",
@@ -450,6 +539,8 @@ var GinkgoCLIRunAndWatchFlags = GinkgoFlags{
 		Usage: "If set, Ginkgo does not merge coverprofiles into one monolithic coverprofile.  The coverprofiles will remain in their respective package directories or in -output-dir if set."},
 	{KeyPath: "C.KeepSeparateReports", Name: "keep-separate-reports", SectionKey: "output",
 		Usage: "If set, Ginkgo does not merge per-suite reports (e.g. -json-report) into one monolithic report for the entire testrun.  The reports will remain in their respective package directories or in -output-dir if set."},
+	{KeyPath: "C.ScheduleFrom", Name: "schedule-from", SectionKey: "parallel", UsageArgument: "file",
+		Usage: "If set, parallel processes will run the slowest specs first using the run times recorded in this JSON report.  If not set, Ginkgo uses the run times it caches in -output-dir after each parallel run."},
 
 	{KeyPath: "D.Stream", DeprecatedName: "stream", DeprecatedDocLink: "removed--stream", DeprecatedVersion: "2.0.0"},
 	{KeyPath: "D.Notify", DeprecatedName: "notify", DeprecatedDocLink: "removed--notify", DeprecatedVersion: "2.0.0"},
@@ -477,6 +568,12 @@ var GinkgoCLIWatchFlags = GinkgoFlags{
 		Usage:             "Only files matching this regular expression will be watched for changes."},
 }
 
+// GinkgoCLIListFlags provides flags for Ginkgo CLI's list command that aren't shared by any other commands
+var GinkgoCLIListFlags = GinkgoFlags{
+	{KeyPath: "C.ListFormat", Name: "format", SectionKey: "output", UsageArgument: "text or json", UsageDefaultValue: "text",
+		Usage: "The format ginkgo list uses to print the specs in each suite."},
+}
+
 // GoBuildFlags provides flags for the Ginkgo CLI build, run, and watch commands that capture go's build-time flags.  These are passed to go test -c by the ginkgo CLI
 var GoBuildFlags = GinkgoFlags{
 	{KeyPath: "Go.Race", Name: "race", SectionKey: "code-and-coverage-analysis",
@@ -563,6 +660,12 @@ func VetAndInitializeCLIAndGoConfig(cliConfig CLIConfig, goFlagsConfig GoFlagsCo
 		errors = append(errors, GinkgoErrors.BothRepeatAndUntilItFails())
 	}
 
+	switch strings.ToLower(cliConfig.ListFormat) {
+	case "", "text", "json":
+	default:
+		errors = append(errors, GinkgoErrors.InvalidListFormat(cliConfig.ListFormat))
+	}
+
 	//initialize the output directory
 	if cliConfig.OutputDir != "" {
 		err := os.MkdirAll(cliConfig.OutputDir, 0777)
@@ -704,6 +807,33 @@ func BuildBuildCommandFlagSet(cliConfig *CLIConfig, goFlagsConfig *GoFlagsConfig
 	return NewGinkgoFlagSet(flags, bindings, flagSections)
 }
 
+// BuildListCommandFlagSet builds the FlagSet for the `ginkgo list` command
+func BuildListCommandFlagSet(suiteConfig *SuiteConfig, reporterConfig *ReporterConfig, cliConfig *CLIConfig, goFlagsConfig *GoFlagsConfig) (GinkgoFlagSet, error) {
+	flags := SuiteConfigFlags.SubsetWithNames("label-filter", "focus", "skip", "focus-file", "skip-file", "focus-id", "skip-id")
+	flags = flags.CopyAppend(ReporterConfigFlags.SubsetWithNames("no-color")...)
+	flags = flags.CopyAppend(GinkgoCLISharedFlags...)
+	flags = flags.CopyAppend(GinkgoCLIListFlags...)
+	flags = flags.CopyAppend(GoBuildFlags...)
+
+	bindings := map[string]interface{}{
+		"S":  suiteConfig,
+		"R":  reporterConfig,
+		"C":  cliConfig,
+		"Go": goFlagsConfig,
+		"D":  &deprecatedConfig{},
+	}
+
+	flagSections := make(GinkgoFlagSections, len(FlagSections))
+	copy(flagSections, FlagSections)
+	for i := range flagSections {
+		if flagSections[i].Key == "multiple-suites" {
+			flagSections[i].Heading = "Listing Specs in Multiple Suites"
+		}
+	}
+
+	return NewGinkgoFlagSet(flags, bindings, flagSections)
+}
+
 func BuildLabelsCommandFlagSet(cliConfig *CLIConfig) (GinkgoFlagSet, error) {
 	flags := GinkgoCLISharedFlags.SubsetWithNames("r", "skip-package")
 
@@ -3,6 +3,7 @@ package types_test
 import (
 	"flag"
 	"net/http"
+	"runtime"
 
 	. "github.com/onsi-experimental/ginkgo/v2"
 	"github.com/onsi-experimental/ginkgo/v2/types"
@@ -28,6 +29,24 @@ var _ = Describe("Config", func() {
 		}
 	})
 
+	Describe("SuiteConfig", func() {
+		Describe("ComputedParallelGoroutines", func() {
+			It("returns 1 unless running in goroutine mode", func() {
+				Ω(types.SuiteConfig{ParallelGoroutines: 4}.ComputedParallelGoroutines()).Should(Equal(1))
+				Ω(types.SuiteConfig{ParallelMode: "processes", ParallelGoroutines: 4}.ComputedParallelGoroutines()).Should(Equal(1))
+			})
+
+			It("returns the requested number of goroutines, defaulting to the number of CPUs", func() {
+				Ω(types.SuiteConfig{ParallelMode: "goroutines", ParallelGoroutines: 4}.ComputedParallelGoroutines()).Should(Equal(4))
+				Ω(types.SuiteConfig{ParallelMode: "goroutines"}.ComputedParallelGoroutines()).Should(Equal(runtime.NumCPU()))
+			})
+
+			It("returns 1 when performing a dry run", func() {
+				Ω(types.SuiteConfig{ParallelMode: "goroutines", ParallelGoroutines: 4, DryRun: true}.ComputedParallelGoroutines()).Should(Equal(1))
+			})
+		})
+	})
+
 	Describe("ReporterConfig", func() {
 		Describe("WillGenerateReport", func() {
 			It("returns true if it will generate a report", func() {
@@ -100,6 +119,7 @@ var _ = Describe("Config", func() {
 			goFlagSet = flag.NewFlagSet("test", flag.ContinueOnError)
 			goFlagSet.Int("count", 1, "")
 			goFlagSet.Int("parallel", 0, "")
+			goFlagSet.Int64("ginkgo.seed", 0, "")
 			flagSet, err = types.NewAttachedGinkgoFlagSet(goFlagSet, types.GinkgoFlags{}, nil, types.GinkgoFlagSections{}, types.GinkgoFlagSection{})
 			Ω(err).ShouldNot(HaveOccurred())
 
@@ -188,9 +208,9 @@ var _ = Describe("Config", func() {
 					BeforeEach(func() {
 						suiteConf.DryRun = true
 					})
-					It("errors", func() {
+					It("does not error", func() {
 						errors := types.VetConfig(flagSet, suiteConf, repConf)
-						Ω(errors).Should(ConsistOf(types.GinkgoErrors.DryRunInParallelConfiguration()))
+						Ω(errors).Should(BeEmpty())
 					})
 				})
 			})
@@ -210,6 +230,14 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Context("when both --flake-attempts and --must-pass-repeatedly are set", func() {
+			It("errors", func() {
+				suiteConf.FlakeAttempts, suiteConf.MustPassRepeatedly = 2, 3
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.FlakeAttemptsAndMustPassRepeatedlyConfiguration()))
+			})
+		})
+
 		Describe("validating --output-interceptor-mode", func() {
 			It("errors if an invalid output interceptor mode is specified", func() {
 				suiteConf.OutputInterceptorMode = "DURP"
@@ -224,6 +252,77 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("validating --parallel-mode", func() {
+			It("errors if an invalid parallel mode is specified", func() {
+				suiteConf.ParallelMode = "threads"
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidParallelModeConfiguration("threads")))
+
+				for _, value := range []string{"", "processes", "PROCESSES", "goroutines", "GOROUTINES"} {
+					suiteConf.ParallelMode = value
+					errors = types.VetConfig(flagSet, suiteConf, repConf)
+					Ω(errors).Should(BeEmpty())
+				}
+			})
+
+			It("errors if goroutine mode is combined with multiple processes", func() {
+				suiteConf.ParallelMode = "goroutines"
+				suiteConf.ParallelTotal = 2
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ContainElement(types.GinkgoErrors.GoroutineParallelModeWithMultipleProcessesConfiguration()))
+			})
+		})
+
+		Describe("validating --shard-index and --shard-total", func() {
+			BeforeEach(func() {
+				goFlagSet.Parse([]string{"-ginkgo.seed=17"})
+			})
+
+			It("errors if the shard index is out of range", func() {
+				suiteConf.ShardIndex, suiteConf.ShardTotal = 3, 2
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidShardConfiguration(3, 2)))
+
+				suiteConf.ShardIndex, suiteConf.ShardTotal = 0, 2
+				errors = types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidShardConfiguration(0, 2)))
+
+				suiteConf.ShardIndex, suiteConf.ShardTotal = 1, -1
+				errors = types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidShardConfiguration(1, -1)))
+			})
+
+			It("does not error for valid shards, or when not sharding", func() {
+				for _, shard := range [][]int{{1, 1}, {0, 0}, {1, 0}, {1, 3}, {3, 3}} {
+					suiteConf.ShardIndex, suiteConf.ShardTotal = shard[0], shard[1]
+					errors := types.VetConfig(flagSet, suiteConf, repConf)
+					Ω(errors).Should(BeEmpty())
+				}
+			})
+
+			It("errors if the seed was not set explicitly as each shard would pick its own seed", func() {
+				goFlagSet = flag.NewFlagSet("test", flag.ContinueOnError)
+				flagSet, _ = types.NewAttachedGinkgoFlagSet(goFlagSet, types.GinkgoFlags{}, nil, types.GinkgoFlagSections{}, types.GinkgoFlagSection{})
+				suiteConf.ShardIndex, suiteConf.ShardTotal = 1, 2
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.ShardingWithoutSeedConfiguration()))
+			})
+		})
+
+		Describe("validating --order-by", func() {
+			It("errors if an invalid order-by value is specified", func() {
+				suiteConf.OrderBy = "name"
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidOrderByConfiguration("name")))
+
+				for _, value := range []string{"", "location", "LOCATION", "identity", "IDENTITY"} {
+					suiteConf.OrderBy = value
+					errors = types.VetConfig(flagSet, suiteConf, repConf)
+					Ω(errors).Should(BeEmpty())
+				}
+			})
+		})
+
 		Context("when more than one verbosity flag is set", func() {
 			It("errors", func() {
 				repConf.Succinct, repConf.Verbose, repConf.VeryVerbose = true, true, false
@@ -70,6 +70,14 @@ func (g ginkgoErrors) RerunningSuite() error {
 	}
 }
 
+func (g ginkgoErrors) MultipleSuitesInParallel() error {
+	return GinkgoError{
+		Heading: "Multiple Suites in Parallel",
+		Message: formatter.F(`It looks like you are running more than one suite in the same package in parallel.  Ginkgo's parallel processes share a single connection to the Ginkgo CLI so they can only run one suite per package.  Move suites that need to run in parallel into separate packages, or use {{bold}}go test -run{{/}} (or {{bold}}ginkgo -- -test.run{{/}}) to run one suite at a time.`),
+		DocLink: "running-multiple-suites-in-one-package",
+	}
+}
+
 /* Tree construction errors */
 
 func (g ginkgoErrors) PushingNodeInRunPhase(nodeType NodeType, cl CodeLocation) error {
@@ -108,6 +116,9 @@ Please ensure all assertions are inside leaf nodes such as {{bold}}BeforeEach{{/
 
 func (g ginkgoErrors) SuiteNodeInNestedContext(nodeType NodeType, cl CodeLocation) error {
 	docLink := "suite-setup-and-cleanup-beforesuite-and-aftersuite"
+	if nodeType.Is(NodeTypeReportBeforeSuite) {
+		docLink = "reporting-nodes---reportbeforesuite"
+	}
 	if nodeType.Is(NodeTypeReportAfterSuite) {
 		docLink = "reporting-nodes---reportaftersuite"
 	}
@@ -125,6 +136,9 @@ func (g ginkgoErrors) SuiteNodeInNestedContext(nodeType NodeType, cl CodeLocatio
 
 func (g ginkgoErrors) SuiteNodeDuringRunPhase(nodeType NodeType, cl CodeLocation) error {
 	docLink := "suite-setup-and-cleanup-beforesuite-and-aftersuite"
+	if nodeType.Is(NodeTypeReportBeforeSuite) {
+		docLink = "reporting-nodes---reportbeforesuite"
+	}
 	if nodeType.Is(NodeTypeReportAfterSuite) {
 		docLink = "reporting-nodes---reportaftersuite"
 	}
@@ -180,6 +194,15 @@ func (g ginkgoErrors) InvalidDeclarationOfFocusedAndPending(cl CodeLocation, nod
 	}
 }
 
+func (g ginkgoErrors) InvalidDeclarationOfFlakeAttemptsAndMustPassRepeatedly(cl CodeLocation, nodeType NodeType) error {
+	return GinkgoError{
+		Heading:      "Invalid Combination of Decorators: FlakeAttempts and MustPassRepeatedly",
+		Message:      formatter.F(`[%s] node was decorated with both FlakeAttempts and MustPassRepeatedly.  At most one is allowed.`, nodeType),
+		CodeLocation: cl,
+		DocLink:      "node-decorators-overview",
+	}
+}
+
 func (g ginkgoErrors) UnknownDecorator(cl CodeLocation, nodeType NodeType, decoration interface{}) error {
 	return GinkgoError{
 		Heading:      "Unkown Decorator",
@@ -192,13 +215,32 @@ func (g ginkgoErrors) UnknownDecorator(cl CodeLocation, nodeType NodeType, decor
 func (g ginkgoErrors) InvalidBodyType(t reflect.Type, cl CodeLocation, nodeType NodeType) error {
 	return GinkgoError{
 		Heading: "Invalid Function",
-		Message: formatter.F(`[%s] node must be passed {{bold}}func(){{/}} - i.e. functions that take nothing and return nothing.
+		Message: formatter.F(`[%s] node must be passed {{bold}}func(){{/}} - i.e. functions that take nothing and return nothing.  Setup nodes and subject nodes can also be passed {{bold}}func(ctx SpecContext){{/}} or {{bold}}func(ctx context.Context){{/}}.
+You passed {{bold}}%s{{/}} instead.`, nodeType, t),
+		CodeLocation: cl,
+		DocLink:      "node-decorators-overview",
+	}
+}
+
+func (g ginkgoErrors) InvalidBodyTypeForContainer(t reflect.Type, cl CodeLocation, nodeType NodeType) error {
+	return GinkgoError{
+		Heading: "Invalid Function",
+		Message: formatter.F(`[%s] node must be passed {{bold}}func(){{/}} - i.e. functions that take nothing and return nothing.  Container nodes cannot be passed a context.
 You passed {{bold}}%s{{/}} instead.`, nodeType, t),
 		CodeLocation: cl,
 		DocLink:      "node-decorators-overview",
 	}
 }
 
+func (g ginkgoErrors) InvalidTimeoutForNonContextNode(cl CodeLocation, nodeType NodeType, decoration string) error {
+	return GinkgoError{
+		Heading:      "Invalid Timeout",
+		Message:      formatter.F(`[%s] was passed a '%s' decoration but does not have a body function that accepts a {{bold}}SpecContext{{/}} or {{bold}}context.Context{{/}}.  Ginkgo can only signal a timeout to nodes that accept a context.`, nodeType, decoration),
+		CodeLocation: cl,
+		DocLink:      "spec-timeouts-and-interruptible-nodes",
+	}
+}
+
 func (g ginkgoErrors) MultipleBodyFunctions(cl CodeLocation, nodeType NodeType) error {
 	return GinkgoError{
 		Heading:      "Multiple Functions",
@@ -227,6 +269,15 @@ func (g ginkgoErrors) InvalidSerialNodeInNonSerialOrderedContainer(cl CodeLocati
 	}
 }
 
+func (g ginkgoErrors) InvalidContinueOnFailureDecoration(cl CodeLocation) error {
+	return GinkgoError{
+		Heading:      "ContinueOnFailure not decorating an Ordered Container",
+		Message:      "ContinueOnFailure can only decorate an Ordered container.  Add the Ordered decorator to the container, or remove ContinueOnFailure.",
+		CodeLocation: cl,
+		DocLink:      "ordered-containers",
+	}
+}
+
 func (g ginkgoErrors) SetupNodeNotInOrderedContainer(cl CodeLocation, nodeType NodeType) error {
 	return GinkgoError{
 		Heading:      "Setup Node not in Ordered Container",
@@ -240,7 +291,7 @@ func (g ginkgoErrors) SetupNodeNotInOrderedContainer(cl CodeLocation, nodeType N
 func (g ginkgoErrors) DeferCleanupInvalidFunction(cl CodeLocation) error {
 	return GinkgoError{
 		Heading:      "DeferCleanup requires a valid function",
-		Message:      "You must pass DeferCleanup a function to invoke.  This function must return zero or one values - if it does return, it must return an error.  The function can take arbitrarily many arguments and you should provide these to DeferCleanup to pass along to the function.",
+		Message:      "You must pass DeferCleanup a function to invoke.  This function must return zero or one values - if it does return, it must return an error.  The function can take arbitrarily many arguments and you should provide these to DeferCleanup to pass along to the function.  If the function's first argument is a SpecContext or context.Context and you don't provide one, Ginkgo will pass in the context of the running cleanup node.",
 		CodeLocation: cl,
 		DocLink:      "cleaning-up-our-cleanup-code-defercleanup",
 	}
@@ -258,7 +309,7 @@ func (g ginkgoErrors) PushingCleanupNodeDuringTreeConstruction(cl CodeLocation)
 func (g ginkgoErrors) PushingCleanupInReportingNode(cl CodeLocation, nodeType NodeType) error {
 	return GinkgoError{
 		Heading:      fmt.Sprintf("DeferCleanup cannot be called in %s", nodeType),
-		Message:      "Please inline your cleanup code - Ginkgo won't run cleanup code after a ReportAfterEach or ReportAfterSuite.",
+		Message:      "Please inline your cleanup code - Ginkgo won't run cleanup code after a ReportAfterEach, ReportBeforeSuite, or ReportAfterSuite.",
 		CodeLocation: cl,
 		DocLink:      "cleaning-up-our-cleanup-code-defercleanup",
 	}
@@ -342,6 +393,15 @@ func (g ginkgoErrors) InvalidLabel(label string, cl CodeLocation) error {
 	}
 }
 
+func (g ginkgoErrors) InvalidKeyValueLabel(label string, cl CodeLocation) error {
+	return GinkgoError{
+		Heading:      "Invalid Key/Value Label",
+		Message:      fmt.Sprintf("'%s' is an invalid key/value label.  The values of key/value labels cannot contain any of the following characters: '<>='", label),
+		CodeLocation: cl,
+		DocLink:      "keyvalue-labels",
+	}
+}
+
 func (g ginkgoErrors) InvalidEmptyLabel(cl CodeLocation) error {
 	return GinkgoError{
 		Heading:      "Invalid Empty Label",
@@ -472,10 +532,81 @@ func (g ginkgoErrors) UnreachableParallelHost(host string) error {
 	}
 }
 
-func (g ginkgoErrors) DryRunInParallelConfiguration() error {
+func (g ginkgoErrors) InvalidShardConfiguration(index int, total int) error {
 	return GinkgoError{
-		Heading: "Ginkgo only performs -dryRun in serial mode.",
-		Message: "Please try running ginkgo -dryRun again, but without -p or -procs to ensure the suite is running in series.",
+		Heading: fmt.Sprintf("Invalid shard %d of %d.", index, total),
+		Message: "--shard-index must be between 1 and --shard-total.",
+		DocLink: "sharding-specs-across-machines",
+	}
+}
+
+func (g ginkgoErrors) ShardingWithoutSeedConfiguration() error {
+	return GinkgoError{
+		Heading: "Sharding requires an explicit --seed.",
+		Message: "Ginkgo assigns specs to shards using the random seed.  Please pass the same --seed to every shard so that each spec runs on exactly one shard.",
+		DocLink: "sharding-specs-across-machines",
+	}
+}
+
+func (g ginkgoErrors) InProcessSuiteInParallelConfiguration() error {
+	return GinkgoError{
+		Heading: "Ginkgo only runs in-process suites in serial mode.",
+		Message: "RunSuiteInProcess does not support running specs in parallel.  Please set ParallelTotal and ParallelProcess to 1.",
+		DocLink: "running-suites-in-process",
+	}
+}
+
+func (g ginkgoErrors) InvalidParallelModeConfiguration(value string) error {
+	return GinkgoError{
+		Heading: fmt.Sprintf("Invalid value '%s' for --parallel-mode.", value),
+		Message: "You must choose one of 'processes' or 'goroutines'.",
+		DocLink: "running-specs-in-parallel-goroutines",
+	}
+}
+
+func (g ginkgoErrors) InvalidOrderByConfiguration(value string) error {
+	return GinkgoError{
+		Heading: fmt.Sprintf("Invalid value '%s' for --order-by.", value),
+		Message: "You must choose one of 'location' or 'identity'.",
+		DocLink: "keeping-spec-order-stable-across-code-changes",
+	}
+}
+
+func (g ginkgoErrors) GoroutineParallelModeWithMultipleProcessesConfiguration() error {
+	return GinkgoError{
+		Heading: "--parallel-mode=goroutines runs specs in a single process.",
+		Message: "Ginkgo can run specs in parallel across processes or across goroutines, but not both.  Please set ParallelTotal and ParallelProcess to 1 when using --parallel-mode=goroutines.",
+		DocLink: "running-specs-in-parallel-goroutines",
+	}
+}
+
+func (g ginkgoErrors) GoroutineParallelModeUnsupportedByRuntime(goVersion string) error {
+	return GinkgoError{
+		Heading: "--parallel-mode=goroutines is not supported by " + goVersion + ".",
+		Message: "Ginkgo identifies the spec that launched a goroutine by the creator recorded in the goroutine's stack trace.  Go only records the creator's goroutine ID in Go 1.21 and later.  Please upgrade Go or use --parallel-mode=processes.",
+		DocLink: "running-specs-in-parallel-goroutines",
+	}
+}
+
+func (g ginkgoErrors) UnattributableGoroutineInGoroutineParallelMode(action string, cl CodeLocation) error {
+	return GinkgoError{
+		Heading:      "Ginkgo could not determine which spec is running",
+		Message:      formatter.F(`{{bold}}%s{{/}} was called from a goroutine that Ginkgo cannot associate with a running spec.  When running with {{bold}}--parallel-mode=goroutines{{/}} several specs run at once and Ginkgo identifies the current spec by the goroutine that is running it.  Make sure you only call %s from within a node's body, or from goroutines launched by a node's body that are still running - or run the suite with {{bold}}--parallel-mode=processes{{/}} instead.`, action, action),
+		CodeLocation: cl,
+		DocLink:      "running-specs-in-parallel-goroutines",
+	}
+}
+
+func (g ginkgoErrors) UnattributableFailureInGoroutineParallelMode(action string, failureMessage string, cl CodeLocation) error {
+	err := g.UnattributableGoroutineInGoroutineParallelMode(action, cl).(GinkgoError)
+	err.Message += formatter.F("\n\n{{bold}}%s{{/}} was called with:\n%s", action, failureMessage)
+	return err
+}
+
+func (g ginkgoErrors) FlakeAttemptsAndMustPassRepeatedlyConfiguration() error {
+	return GinkgoError{
+		Heading: "Conflicting retry configuration.",
+		Message: "--flake-attempts and --must-pass-repeatedly are mutually exclusive.  Please set at most one of them.",
 	}
 }
 
@@ -513,3 +644,11 @@ func (g ginkgoErrors) BothRepeatAndUntilItFails() error {
 		Message: "--until-it-fails directs Ginkgo to rerun specs indefinitely until they fail.  --repeat directs Ginkgo to rerun specs a set number of times.  You can't set both... which would you like?",
 	}
 }
+
+func (g ginkgoErrors) InvalidListFormat(format string) error {
+	return GinkgoError{
+		Heading: fmt.Sprintf("Invalid value '%s' for --format.", format),
+		Message: "ginkgo list can print specs as either 'text' or 'json'.",
+		DocLink: "listing-specs",
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -33,6 +34,89 @@ func matchLabelRegexAction(regex *regexp.Regexp) LabelFilter {
 	}
 }
 
+// splitKeyValueLabel splits a key/value label of the form key:value.  ok is false if label is not a key/value label.  Labels are only treated as
+// key/value labels if the key and value are both non-empty and the key contains no whitespace or comparison operators - any other label containing ':' is a plain label.
+func splitKeyValueLabel(label string) (key string, value string, ok bool) {
+	idx := strings.Index(label, ":")
+	if idx == -1 {
+		return "", "", false
+	}
+	key, value = strings.TrimSpace(label[:idx]), strings.TrimSpace(label[idx+1:])
+	if key == "" || value == "" || strings.ContainsAny(key, " \t<>=") {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+func labelValuesForKey(labels []string, key string) []string {
+	values := []string{}
+	for i := range labels {
+		if labelKey, value, ok := splitKeyValueLabel(labels[i]); ok && strings.EqualFold(labelKey, key) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// labelValuesAreEqual compares numeric values numerically and all other values as case-insensitive strings
+func labelValuesAreEqual(a, b string) bool {
+	aNum, aErr := strconv.ParseFloat(a, 64)
+	bNum, bErr := strconv.ParseFloat(b, 64)
+	if aErr == nil && bErr == nil {
+		return aNum == bNum
+	}
+	return strings.EqualFold(a, b)
+}
+
+func hasKeyAction(key string) LabelFilter {
+	return func(labels []string) bool {
+		return len(labelValuesForKey(labels, key)) > 0
+	}
+}
+
+func keyValueInAction(key string, expectedValues []string) LabelFilter {
+	return func(labels []string) bool {
+		for _, value := range labelValuesForKey(labels, key) {
+			for _, expected := range expectedValues {
+				if labelValuesAreEqual(value, expected) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+func keyValueComparisonAction(key string, operator string, operand float64) LabelFilter {
+	return func(labels []string) bool {
+		for _, value := range labelValuesForKey(labels, key) {
+			num, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			switch operator {
+			case ">":
+				if num > operand {
+					return true
+				}
+			case ">=":
+				if num >= operand {
+					return true
+				}
+			case "<":
+				if num < operand {
+					return true
+				}
+			case "<=":
+				if num <= operand {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
 func notAction(filter LabelFilter) LabelFilter {
 	return func(labels []string) bool { return !filter(labels) }
 }
@@ -58,6 +142,9 @@ const (
 	lfTokenOr
 	lfTokenRegexp
 	lfTokenLabel
+	lfTokenKeyValueComparison
+	lfTokenKeyValueIn
+	lfTokenHasKey
 	lfTokenEOF
 )
 
@@ -93,6 +180,12 @@ func (l lfToken) String() string {
 		return "/regexp/"
 	case lfTokenLabel:
 		return "label"
+	case lfTokenKeyValueComparison:
+		return "key/value comparison"
+	case lfTokenKeyValueIn:
+		return "key in (values)"
+	case lfTokenHasKey:
+		return "has(key)"
 	case lfTokenEOF:
 		return "EOF"
 	}
@@ -104,6 +197,11 @@ type treeNode struct {
 	location int
 	value    string
 
+	// key, operator, and values are only set for key/value tokens
+	key      string
+	operator string
+	values   []string
+
 	parent    *treeNode
 	leftNode  *treeNode
 	rightNode *treeNode
@@ -148,6 +246,19 @@ func (tn *treeNode) constructLabelFilter(input string) (LabelFilter, error) {
 			return nil, GinkgoErrors.SyntaxErrorParsingLabelFilter(input, tn.location, fmt.Sprintf("RegExp compilation error: %s", err))
 		}
 		return matchLabelRegexAction(re), nil
+	case lfTokenHasKey:
+		return hasKeyAction(tn.key), nil
+	case lfTokenKeyValueIn:
+		return keyValueInAction(tn.key, tn.values), nil
+	case lfTokenKeyValueComparison:
+		if tn.operator == "=" {
+			return keyValueInAction(tn.key, []string{tn.value}), nil
+		}
+		operand, err := strconv.ParseFloat(tn.value, 64)
+		if err != nil {
+			return nil, GinkgoErrors.SyntaxErrorParsingLabelFilter(input, tn.location, fmt.Sprintf("'%s' can only compare numeric values - '%s' is not a number.", tn.operator, tn.value))
+		}
+		return keyValueComparisonAction(tn.key, tn.operator, operand), nil
 	}
 
 	if tn.rightNode == nil {
@@ -185,9 +296,18 @@ func (tn *treeNode) constructLabelFilter(input string) (LabelFilter, error) {
 
 func (tn *treeNode) tokenString() string {
 	out := fmt.Sprintf("<%s", tn.token)
+	if tn.key != "" {
+		out += " | " + tn.key
+	}
+	if tn.operator != "" {
+		out += " " + tn.operator
+	}
 	if tn.value != "" {
 		out += " | " + tn.value
 	}
+	if len(tn.values) > 0 {
+		out += " | " + strings.Join(tn.values, ",")
+	}
 	out += ">"
 	return out
 }
@@ -223,6 +343,25 @@ func tokenize(input string) func() (*treeNode, error) {
 		return string(runes[i:j]), j - i
 	}
 
+	// consumeGroup consumes a parenthesized group that follows a label-like token (e.g. has(key) or key in (a,b)) and returns its contents
+	consumeGroup := func() (string, bool) {
+		j := i
+		for j < len(runes) && runes[j] == ' ' {
+			j += 1
+		}
+		if j >= len(runes) || runes[j] != '(' {
+			return "", false
+		}
+		i = j + 1
+		value, n := consumeUntil(")")
+		i += n
+		if i >= len(runes) {
+			return "", false
+		}
+		i += 1
+		return value, true
+	}
+
 	return func() (*treeNode, error) {
 		for i < len(runes) && runes[i] == ' ' {
 			i += 1
@@ -266,10 +405,53 @@ func tokenize(input string) func() (*treeNode, error) {
 		default:
 			value, n := consumeUntil("&|!,()/")
 			i += n
-			node.token, node.value = lfTokenLabel, strings.TrimSpace(value)
+			value = strings.TrimSpace(value)
+			if value == "has" || lfInRegexp.MatchString(value) {
+				start := i
+				group, ok := consumeGroup()
+				if !ok && i != start {
+					return &treeNode{}, GinkgoErrors.SyntaxErrorParsingLabelFilter(input, node.location, "Mismatched '(' - could not find matching ')'.")
+				}
+				if ok {
+					return keyValueGroupNode(input, node, value, group)
+				}
+			}
+			if match := lfComparisonRegexp.FindStringSubmatch(value); match != nil {
+				node.token, node.key, node.operator, node.value = lfTokenKeyValueComparison, match[1], match[2], match[3]
+			} else if strings.ContainsAny(value, "<>=") {
+				return &treeNode{}, GinkgoErrors.SyntaxErrorParsingLabelFilter(input, node.location, fmt.Sprintf("Malformed comparison '%s'.  Comparisons must have the form 'key>=value'.", value))
+			} else if key, keyValue, ok := splitKeyValueLabel(value); ok {
+				node.token, node.key, node.operator, node.value = lfTokenKeyValueComparison, key, "=", keyValue
+			} else {
+				node.token, node.value = lfTokenLabel, value
+			}
+		}
+		return node, nil
+	}
+}
+
+var lfComparisonRegexp = regexp.MustCompile(`^([^\s<>=:]+)\s*(>=|<=|>|<|=)\s*([^\s<>=]+)$`)
+var lfInRegexp = regexp.MustCompile(`^([^\s<>=:]+)\s+in$`)
+
+// keyValueGroupNode builds the node for has(key) and key in (a,b) tokens
+func keyValueGroupNode(input string, node *treeNode, value string, group string) (*treeNode, error) {
+	if value == "has" {
+		key := strings.TrimSpace(group)
+		if key == "" || strings.ContainsAny(key, " <>=:") {
+			return &treeNode{}, GinkgoErrors.SyntaxErrorParsingLabelFilter(input, node.location, fmt.Sprintf("Invalid key '%s' in has(key).", key))
 		}
+		node.token, node.key = lfTokenHasKey, key
 		return node, nil
 	}
+	node.token, node.key = lfTokenKeyValueIn, lfInRegexp.FindStringSubmatch(value)[1]
+	for _, v := range strings.Split(group, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return &treeNode{}, GinkgoErrors.SyntaxErrorParsingLabelFilter(input, node.location, fmt.Sprintf("Empty value in '%s (%s)'.", value, group))
+		}
+		node.values = append(node.values, v)
+	}
+	return node, nil
 }
 
 func ParseLabelFilter(input string) (LabelFilter, error) {
@@ -296,7 +478,7 @@ LOOP:
 		switch node.token {
 		case lfTokenEOF:
 			break LOOP
-		case lfTokenLabel, lfTokenRegexp:
+		case lfTokenLabel, lfTokenRegexp, lfTokenKeyValueComparison, lfTokenKeyValueIn, lfTokenHasKey:
 			if current.rightNode != nil {
 				return nil, GinkgoErrors.SyntaxErrorParsingLabelFilter(input, node.location, "Found two adjacent labels.  You need an operator between them.")
 			}
@@ -343,5 +525,12 @@ func ValidateAndCleanupLabel(label string, cl CodeLocation) (string, error) {
 	if strings.ContainsAny(out, "&|!,()/") {
 		return "", GinkgoErrors.InvalidLabel(label, cl)
 	}
+	if key, value, ok := splitKeyValueLabel(out); ok {
+		// '<', '>', and '=' are comparison operators in label filters so a key/value label whose value contains them could never be matched
+		if strings.ContainsAny(value, "<>=") {
+			return "", GinkgoErrors.InvalidKeyValueLabel(label, cl)
+		}
+		out = key + ":" + value
+	}
 	return out, nil
 }
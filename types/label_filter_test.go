@@ -40,6 +40,13 @@ var _ = Describe("LabelFilter", func() {
 		Entry(nil, " || B", 1, "Operator '||' missing left hand operand."),
 		Entry(nil, "&&", 0, "Operator '&&' missing left hand operand."),
 		Entry(nil, "&& || B", 0, "Operator '&&' missing left hand operand."),
+		Entry(nil, "priority>=", 0, "Malformed comparison 'priority>='.  Comparisons must have the form 'key>=value'."),
+		Entry(nil, "A && >=2", 5, "Malformed comparison '>=2'.  Comparisons must have the form 'key>=value'."),
+		Entry(nil, "priority>=high", 0, "'>=' can only compare numeric values - 'high' is not a number."),
+		Entry(nil, "tier in (smoke, core", 0, "Mismatched '(' - could not find matching ')'."),
+		Entry(nil, "tier in (smoke,,core)", 0, "Empty value in 'tier in (smoke,,core)'."),
+		Entry(nil, "A || has( )", 5, "Invalid key '' in has(key)."),
+		Entry(nil, "has(jira) has(owner)", 10, "Found two adjacent labels.  You need an operator between them."),
 	)
 
 	type matchingLabels []string
@@ -159,6 +166,53 @@ var _ = Describe("LabelFilter", func() {
 			M("dog", "cat"), M("dog", "cow"), M("cat", "cow", "dog"), M("dog", "orca"),
 			NM("dog"), NM("cow"), NM("cat"), NM("dog", "fruit"), NM("dog", "cup"),
 		),
+		Entry("Matching key/value labels", "owner:payments",
+			M("owner:payments"), M("OWNER:Payments"), M("owner:billing", "owner:payments"),
+			NM(), NM("owner"), NM("payments"), NM("owner:billing"),
+		),
+		Entry("Matching key/value labels with =", "owner = payments",
+			M("owner:payments"), M("cat", "owner:payments"),
+			NM(), NM("owner:billing"), NM("owner=payments"),
+		),
+		Entry("Matching numeric key/value labels", "priority:2",
+			M("priority:2"), M("priority:2.0"),
+			NM("priority:20"), NM("priority"),
+		),
+		Entry("Comparing with >=", "priority>=2",
+			M("priority:2"), M("priority:3"), M("priority:10"), M("priority:1", "priority:2"),
+			NM(), NM("priority:1"), NM("priority:high"), NM("severity:3"), NM("priority"),
+		),
+		Entry("Comparing with >", "priority > 2",
+			M("priority:3"),
+			NM("priority:2"), NM("priority:1"),
+		),
+		Entry("Comparing with <=", "priority<=2",
+			M("priority:2"), M("priority:1.5"), M("priority:-1"),
+			NM("priority:3"), NM("priority:high"),
+		),
+		Entry("Comparing with <", "priority<2",
+			M("priority:1"),
+			NM("priority:2"), NM("priority:3"),
+		),
+		Entry("Matching values with in", "tier in (smoke, core)",
+			M("tier:smoke"), M("tier:core"), M("TIER:Core"), M("cat", "tier:extended", "tier:core"),
+			NM(), NM("tier:extended"), NM("smoke"), NM("tier"),
+		),
+		Entry("Checking for keys with has", "has(jira)",
+			M("jira:ABC-123"), M("JIRA:ABC-123", "cat"),
+			NM(), NM("jira"), NM("cat"), NM("jira-ABC-123"), NM("jira:"),
+		),
+		Entry("Labels that are not key/value labels", "has(owner) || owner:payments",
+			NM("the owner:payments"), NM("owner:"), NM(":payments"),
+		),
+		Entry("Combining key/value filters with other operators", "has(jira) && !(tier in (extended)) && (priority>=2 || cat)",
+			M("jira:ABC-123", "priority:2"), M("jira:ABC-123", "tier:smoke", "cat"),
+			NM("jira:ABC-123", "tier:extended", "priority:3"), NM("jira:ABC-123", "priority:1"), NM("priority:3", "cat"),
+		),
+		Entry("Treating has and in as plain labels when they are not followed by a group", "has || tier in",
+			M("has"), M("tier in"),
+			NM("tier:in"),
+		),
 	)
 
 	cl := types.NewCodeLocation(0)
@@ -186,5 +240,17 @@ var _ = Describe("LabelFilter", func() {
 		Entry(nil, "cow()", "", types.GinkgoErrors.InvalidLabel("cow()", cl)),
 		Entry(nil, "cow)", "", types.GinkgoErrors.InvalidLabel("cow)", cl)),
 		Entry(nil, "cow/", "", types.GinkgoErrors.InvalidLabel("cow/", cl)),
+		Entry(nil, "a=b", "a=b", nil),
+		Entry(nil, "k8s>=1.20", "k8s>=1.20", nil),
+		Entry(nil, "cow<", "cow<", nil),
+		Entry(nil, "owner:payments", "owner:payments", nil),
+		Entry(nil, "  owner :  payments ", "owner:payments", nil),
+		Entry(nil, "url:host:8080", "url:host:8080", nil),
+		Entry(nil, ":payments", ":payments", nil),
+		Entry(nil, "issue:", "issue:", nil),
+		Entry(nil, "the owner:payments", "the owner:payments", nil),
+		Entry(nil, "priority>=:2", "priority>=:2", nil),
+		Entry(nil, "version:>=1.20", "", types.GinkgoErrors.InvalidKeyValueLabel("version:>=1.20", cl)),
+		Entry(nil, "expr:a=b", "", types.GinkgoErrors.InvalidKeyValueLabel("expr:a=b", cl)),
 	)
 })
@@ -103,6 +103,51 @@ func (report Report) Add(other Report) Report {
 	return report
 }
 
+//MergeShard combines the reports generated by two shards of a sharded test run (see --shard-index and --shard-total) to form a complete final report.
+//Each shard reports on every spec in the suite, skipping the specs assigned to other shards.  MergeShard keeps the SpecReport from the shard that ran each spec.
+//Suite-level nodes (e.g. BeforeSuite and ReportAfterSuite) run on every shard - MergeShard keeps one SpecReport for each, preferring a shard on which the node failed.
+func (report Report) MergeShard(other Report) Report {
+	merged := report.Add(other)
+	merged.SuiteHasProgrammaticFocus = report.SuiteHasProgrammaticFocus || other.SuiteHasProgrammaticFocus
+	merged.PreRunStats.SpecsThatWillRun = report.PreRunStats.SpecsThatWillRun + other.PreRunStats.SpecsThatWillRun
+
+	type suiteNodeKey struct {
+		nodeType        NodeType
+		location        CodeLocation
+		text            string
+		parallelProcess int
+	}
+
+	specReports := SpecReports{}
+	indexBySpecID := map[string]int{}
+	indexBySuiteNode := map[suiteNodeKey]int{}
+	for _, specReport := range merged.SpecReports {
+		if specReport.SpecID == "" {
+			key := suiteNodeKey{specReport.LeafNodeType, specReport.LeafNodeLocation, specReport.LeafNodeText, specReport.ParallelProcess}
+			idx, seen := indexBySuiteNode[key]
+			if !seen {
+				indexBySuiteNode[key] = len(specReports)
+				specReports = append(specReports, specReport)
+			} else if !specReports[idx].Failed() && specReport.Failed() {
+				specReports[idx] = specReport
+			}
+			continue
+		}
+		idx, seen := indexBySpecID[specReport.SpecID]
+		if !seen {
+			indexBySpecID[specReport.SpecID] = len(specReports)
+			specReports = append(specReports, specReport)
+			continue
+		}
+		existing := specReports[idx]
+		if existing.State == SpecStateSkipped && (specReport.State != SpecStateSkipped || (existing.NumAttempts == 0 && specReport.NumAttempts > 0)) {
+			specReports[idx] = specReport
+		}
+	}
+	merged.SpecReports = specReports
+	return merged
+}
+
 // SpecReport captures information about a Ginkgo spec.
 type SpecReport struct {
 	// ContainerHierarchyTexts is a slice containing the text strings of
@@ -125,15 +170,28 @@ type SpecReport struct {
 	LeafNodeLabels   []string
 	LeafNodeText     string
 
+	// SpecID is a stable identifier for the spec derived from its container and leaf node texts and the name of the file it is declared in.
+	// You can run a single spec with ginkgo --focus-id=SpecID.  SpecID is empty for suite-level nodes.
+	SpecID string
+
 	// State captures whether the spec has passed, failed, etc.
 	State SpecState
 
 	// IsSerial captures whether the spec has the Serial decorator
 	IsSerial bool
 
+	// IsFocused captures whether the spec, or any of its containers, is programmatically focused with the Focus decorator or an F-prefixed node
+	IsFocused bool
+
 	// IsInOrderedContainer captures whether the spec appears in an Ordered container
 	IsInOrderedContainer bool
 
+	// SpecPriority captures the priority of the spec, as set by the SpecPriority decorator.  Higher priority specs are scheduled first.
+	SpecPriority int
+
+	// ExclusiveKeys captures the keys passed to the Exclusive decorator in the spec's node hierarchy.  Specs that share a key never run at the same time.
+	ExclusiveKeys []string
+
 	// StartTime and EndTime capture the start and end time of the spec
 	StartTime time.Time
 	EndTime   time.Time
@@ -152,6 +210,10 @@ type SpecReport struct {
 	// ginkgo --flake-attempts=N
 	NumAttempts int
 
+	// MaxMustPassRepeatedly captures the number of times the spec was asked to pass via MustPassRepeatedly or
+	// ginkgo --must-pass-repeatedly=N.  It is zero for specs that are not repeated.
+	MaxMustPassRepeatedly int
+
 	// CapturedGinkgoWriterOutput contains text printed to the GinkgoWriter
 	CapturedGinkgoWriterOutput string
 
@@ -162,6 +224,9 @@ type SpecReport struct {
 
 	// ReportEntries contains any reports added via `AddReportEntry`
 	ReportEntries ReportEntries
+
+	// Steps contains the timeline of steps recorded via `By`, in the order they began
+	Steps SpecSteps
 }
 
 func (report SpecReport) MarshalJSON() ([]byte, error) {
@@ -174,16 +239,20 @@ func (report SpecReport) MarshalJSON() ([]byte, error) {
 		LeafNodeLocation            CodeLocation
 		LeafNodeLabels              []string
 		LeafNodeText                string
+		SpecID                      string `json:",omitempty"`
 		State                       SpecState
+		IsFocused                   bool `json:",omitempty"`
 		StartTime                   time.Time
 		EndTime                     time.Time
 		RunTime                     time.Duration
 		ParallelProcess             int
 		Failure                     *Failure `json:",omitempty"`
 		NumAttempts                 int
+		MaxMustPassRepeatedly       int           `json:",omitempty"`
 		CapturedGinkgoWriterOutput  string        `json:",omitempty"`
 		CapturedStdOutErr           string        `json:",omitempty"`
 		ReportEntries               ReportEntries `json:",omitempty"`
+		Steps                       SpecSteps     `json:",omitempty"`
 	}{
 		ContainerHierarchyTexts:     report.ContainerHierarchyTexts,
 		ContainerHierarchyLocations: report.ContainerHierarchyLocations,
@@ -192,14 +261,18 @@ func (report SpecReport) MarshalJSON() ([]byte, error) {
 		LeafNodeLocation:            report.LeafNodeLocation,
 		LeafNodeLabels:              report.LeafNodeLabels,
 		LeafNodeText:                report.LeafNodeText,
+		SpecID:                      report.SpecID,
 		State:                       report.State,
+		IsFocused:                   report.IsFocused,
 		StartTime:                   report.StartTime,
 		EndTime:                     report.EndTime,
 		RunTime:                     report.RunTime,
 		ParallelProcess:             report.ParallelProcess,
 		Failure:                     nil,
 		ReportEntries:               nil,
+		Steps:                       nil,
 		NumAttempts:                 report.NumAttempts,
+		MaxMustPassRepeatedly:       report.MaxMustPassRepeatedly,
 		CapturedGinkgoWriterOutput:  report.CapturedGinkgoWriterOutput,
 		CapturedStdOutErr:           report.CapturedStdOutErr,
 	}
@@ -210,6 +283,9 @@ func (report SpecReport) MarshalJSON() ([]byte, error) {
 	if len(report.ReportEntries) > 0 {
 		out.ReportEntries = report.ReportEntries
 	}
+	if len(report.Steps) > 0 {
+		out.Steps = report.Steps
+	}
 
 	return json.Marshal(out)
 }
@@ -228,7 +304,7 @@ func (report SpecReport) CombinedOutput() string {
 }
 
 //Failed returns true if report.State is one of the SpecStateFailureStates
-// (SpecStateFailed, SpecStatePanicked, SpecStateinterrupted, SpecStateAborted)
+// (SpecStateFailed, SpecStateTimedout, SpecStatePanicked, SpecStateinterrupted, SpecStateAborted)
 func (report SpecReport) Failed() bool {
 	return report.State.Is(SpecStateFailureStates)
 }
@@ -350,7 +426,7 @@ func (reports SpecReports) CountWithState(states SpecState) int {
 func (reports SpecReports) CountOfFlakedSpecs() int {
 	n := 0
 	for i := range reports {
-		if reports[i].State.Is(SpecStatePassed) && reports[i].NumAttempts > 1 {
+		if reports[i].State.Is(SpecStatePassed) && reports[i].NumAttempts > 1 && reports[i].MaxMustPassRepeatedly == 0 {
 			n += 1
 		}
 	}
@@ -385,12 +461,107 @@ type Failure struct {
 	FailureNodeType           NodeType
 	FailureNodeLocation       CodeLocation
 	FailureNodeContainerIndex int
+
+	// FailureStepText and FailureStepLocation identify the innermost By step that was active when the failure occurred.
+	// They are empty if the failure did not occur during a step.
+	FailureStepText     string `json:",omitempty"`
+	FailureStepLocation CodeLocation
 }
 
 func (f Failure) IsZero() bool {
 	return f == Failure{}
 }
 
+func (f Failure) MarshalJSON() ([]byte, error) {
+	//All this to avoid emitting an empty FailureStepLocation struct in the JSON
+	type failure Failure
+	out := struct {
+		failure
+		FailureStepLocation *CodeLocation `json:",omitempty"`
+	}{
+		failure: failure(f),
+	}
+	if f.FailureStepLocation != (CodeLocation{}) {
+		out.FailureStepLocation = &f.FailureStepLocation
+	}
+	return json.Marshal(out)
+}
+
+// ProgressReport captures the progress of the currently running spec.
+// Ginkgo emits a ProgressReport when it receives a progress signal (SIGINFO or SIGUSR1) or when a node runs longer than PollProgressAfter
+type ProgressReport struct {
+	ParallelProcess   int
+	RunningInParallel bool
+
+	// ContainerHierarchyTexts, LeafNodeText, and LeafNodeLocation identify the running spec.
+	// For suite-level nodes (e.g. BeforeSuite) LeafNodeText is empty and LeafNodeType identifies the node
+	ContainerHierarchyTexts []string
+	LeafNodeType            NodeType
+	LeafNodeText            string
+	LeafNodeLocation        CodeLocation
+	SpecStartTime           time.Time
+
+	// CurrentNodeType, CurrentNodeText, and CurrentNodeLocation identify the node that is currently running
+	CurrentNodeType      NodeType
+	CurrentNodeText      string
+	CurrentNodeLocation  CodeLocation
+	CurrentNodeStartTime time.Time
+
+	// CurrentStepText and CurrentStepLocation capture the most recent By step (if any) emitted by the spec
+	CurrentStepText      string
+	CurrentStepLocation  CodeLocation
+	CurrentStepStartTime time.Time
+
+	// CapturedGinkgoWriterOutput contains the tail of the output written to the GinkgoWriter by the running spec
+	CapturedGinkgoWriterOutput string
+
+	// SpecGoroutineStack contains the stack trace of the goroutine running the current node's body
+	SpecGoroutineStack string
+}
+
+// IsZero returns true if the ProgressReport does not refer to a running spec
+func (pr ProgressReport) IsZero() bool {
+	return pr.CurrentNodeType == NodeTypeInvalid
+}
+
+// NodeEvent captures the lifecycle of an individual node (e.g. a BeforeEach, It, DeferCleanup, or ReportAfterEach node) within a spec.
+// Ginkgo emits a NodeEvent to reporters that implement reporters.NodeReporter just before a node runs and again just after it has run
+type NodeEvent struct {
+	ParallelProcess int
+
+	// NodeType, NodeText, and NodeLocation identify the node
+	NodeType     NodeType
+	NodeText     string
+	NodeLocation CodeLocation
+
+	// StartTime is set for all events.  EndTime and RunTime are only set once the node has run
+	StartTime time.Time
+	EndTime   time.Time
+	RunTime   time.Duration
+
+	// State and Failure capture the node's outcome.  State is SpecStateInvalid until the node has run and Failure is zero if the node passed
+	State   SpecState
+	Failure Failure
+}
+
+// SpecStep captures a single step recorded by calling By within a spec
+type SpecStep struct {
+	// Text and CodeLocation identify the call to By
+	Text         string
+	CodeLocation CodeLocation
+
+	// NestingLevel is zero for steps that are not nested within another step's callback, and increases by one for each level of nesting
+	NestingLevel int
+
+	// StartTime and Duration capture when the step began and how long it lasted.
+	// A step with a callback lasts until the callback returns.  A step without a callback lasts until the next step at the same
+	// (or a shallower) nesting level begins, or until the node it was recorded in ends.
+	StartTime time.Time
+	Duration  time.Duration
+}
+
+type SpecSteps []SpecStep
+
 // FailureNodeContext captures the location context for the node containing the failing line of code
 type FailureNodeContext uint
 
@@ -435,6 +606,7 @@ const (
 	SpecStateAborted
 	SpecStatePanicked
 	SpecStateInterrupted
+	SpecStateTimedout
 )
 
 var ssEnumSupport = NewEnumSupport(map[uint]string{
@@ -446,6 +618,7 @@ var ssEnumSupport = NewEnumSupport(map[uint]string{
 	uint(SpecStateAborted):     "aborted",
 	uint(SpecStatePanicked):    "panicked",
 	uint(SpecStateInterrupted): "interrupted",
+	uint(SpecStateTimedout):    "timedout",
 })
 
 func (ss SpecState) String() string {
@@ -460,7 +633,7 @@ func (ss SpecState) MarshalJSON() ([]byte, error) {
 	return ssEnumSupport.MarshJSON(uint(ss))
 }
 
-var SpecStateFailureStates = SpecStateFailed | SpecStateAborted | SpecStatePanicked | SpecStateInterrupted
+var SpecStateFailureStates = SpecStateFailed | SpecStateTimedout | SpecStateAborted | SpecStatePanicked | SpecStateInterrupted
 
 func (ss SpecState) Is(states SpecState) bool {
 	return ss&states != 0
@@ -490,6 +663,7 @@ const (
 
 	NodeTypeReportBeforeEach
 	NodeTypeReportAfterEach
+	NodeTypeReportBeforeSuite
 	NodeTypeReportAfterSuite
 
 	NodeTypeCleanupInvalid
@@ -499,7 +673,8 @@ const (
 )
 
 var NodeTypesForContainerAndIt = NodeTypeContainer | NodeTypeIt
-var NodeTypesForSuiteLevelNodes = NodeTypeBeforeSuite | NodeTypeSynchronizedBeforeSuite | NodeTypeAfterSuite | NodeTypeSynchronizedAfterSuite | NodeTypeReportAfterSuite
+var NodeTypesForSuiteLevelNodes = NodeTypeBeforeSuite | NodeTypeSynchronizedBeforeSuite | NodeTypeAfterSuite | NodeTypeSynchronizedAfterSuite | NodeTypeReportBeforeSuite | NodeTypeReportAfterSuite
+var NodeTypesForSetupAndSubject = NodeTypeIt | NodeTypeBeforeEach | NodeTypeJustBeforeEach | NodeTypeAfterEach | NodeTypeJustAfterEach | NodeTypeBeforeAll | NodeTypeAfterAll | NodeTypeBeforeSuite | NodeTypeAfterSuite
 
 var ntEnumSupport = NewEnumSupport(map[uint]string{
 	uint(NodeTypeInvalid):                 "INVALID NODE TYPE",
@@ -517,6 +692,7 @@ var ntEnumSupport = NewEnumSupport(map[uint]string{
 	uint(NodeTypeSynchronizedAfterSuite):  "SynchronizedAfterSuite",
 	uint(NodeTypeReportBeforeEach):        "ReportBeforeEach",
 	uint(NodeTypeReportAfterEach):         "ReportAfterEach",
+	uint(NodeTypeReportBeforeSuite):       "ReportBeforeSuite",
 	uint(NodeTypeReportAfterSuite):        "ReportAfterSuite",
 	uint(NodeTypeCleanupInvalid):          "INVALID CLEANUP NODE",
 	uint(NodeTypeCleanupAfterEach):        "DeferCleanup (AfterEach)",
@@ -57,6 +57,92 @@ var _ = Describe("Types", func() {
 
 			})
 		})
+
+		Describe("MergeShard", func() {
+			It("keeps the spec report from the shard that ran each spec and combines the suite-level details", func() {
+				t := time.Now()
+				reportA := types.Report{
+					SuitePath:      "foo",
+					SuiteSucceeded: true,
+					StartTime:      t.Add(-time.Minute),
+					EndTime:        t.Add(2 * time.Minute),
+					PreRunStats:    types.PreRunStats{TotalSpecs: 4, SpecsThatWillRun: 2},
+					SpecReports: types.SpecReports{
+						types.SpecReport{LeafNodeType: types.NodeTypeBeforeSuite, State: types.SpecStatePassed, NumAttempts: 1},
+						types.SpecReport{SpecID: "a", State: types.SpecStatePassed, NumAttempts: 1},
+						types.SpecReport{SpecID: "b", State: types.SpecStateSkipped},
+						types.SpecReport{SpecID: "c", State: types.SpecStateSkipped, NumAttempts: 1, Failure: types.Failure{Message: "skipped at runtime"}},
+						types.SpecReport{SpecID: "d", State: types.SpecStateSkipped},
+					},
+				}
+
+				reportB := types.Report{
+					SuitePath:                 "foo",
+					SuiteSucceeded:            false,
+					SuiteHasProgrammaticFocus: true,
+					StartTime:                 t.Add(-2 * time.Minute),
+					EndTime:                   t.Add(time.Minute),
+					PreRunStats:               types.PreRunStats{TotalSpecs: 4, SpecsThatWillRun: 1},
+					SpecReports: types.SpecReports{
+						types.SpecReport{LeafNodeType: types.NodeTypeBeforeSuite, State: types.SpecStatePassed, NumAttempts: 1},
+						types.SpecReport{SpecID: "a", State: types.SpecStateSkipped},
+						types.SpecReport{SpecID: "b", State: types.SpecStateFailed, NumAttempts: 1},
+						types.SpecReport{SpecID: "c", State: types.SpecStateSkipped},
+						types.SpecReport{SpecID: "d", State: types.SpecStateSkipped},
+					},
+				}
+
+				merged := reportA.MergeShard(reportB)
+				Ω(merged.SuiteSucceeded).Should(BeFalse())
+				Ω(merged.SuiteHasProgrammaticFocus).Should(BeTrue())
+				Ω(merged.RunTime).Should(Equal(4 * time.Minute))
+				Ω(merged.PreRunStats).Should(Equal(types.PreRunStats{TotalSpecs: 4, SpecsThatWillRun: 3}))
+				Ω(merged.SpecReports).Should(Equal(types.SpecReports{
+					types.SpecReport{LeafNodeType: types.NodeTypeBeforeSuite, State: types.SpecStatePassed, NumAttempts: 1},
+					types.SpecReport{SpecID: "a", State: types.SpecStatePassed, NumAttempts: 1},
+					types.SpecReport{SpecID: "b", State: types.SpecStateFailed, NumAttempts: 1},
+					types.SpecReport{SpecID: "c", State: types.SpecStateSkipped, NumAttempts: 1, Failure: types.Failure{Message: "skipped at runtime"}},
+					types.SpecReport{SpecID: "d", State: types.SpecStateSkipped},
+				}))
+			})
+
+			It("keeps a single report for each suite-level node, preferring the shard on which it failed", func() {
+				beforeSuiteCL, afterSuiteCL, reportBeforeSuiteCL, reportAfterSuiteCL := types.NewCodeLocation(0), types.NewCodeLocation(0), types.NewCodeLocation(0), types.NewCodeLocation(0)
+				reportA := types.Report{
+					SuitePath: "foo",
+					SpecReports: types.SpecReports{
+						types.SpecReport{LeafNodeType: types.NodeTypeReportBeforeSuite, LeafNodeLocation: reportBeforeSuiteCL, State: types.SpecStatePassed, NumAttempts: 1},
+						types.SpecReport{LeafNodeType: types.NodeTypeBeforeSuite, LeafNodeLocation: beforeSuiteCL, State: types.SpecStatePassed, NumAttempts: 1},
+						types.SpecReport{SpecID: "a", LeafNodeType: types.NodeTypeIt, State: types.SpecStatePassed, NumAttempts: 1},
+						types.SpecReport{SpecID: "b", LeafNodeType: types.NodeTypeIt, State: types.SpecStateSkipped},
+						types.SpecReport{LeafNodeType: types.NodeTypeAfterSuite, LeafNodeLocation: afterSuiteCL, State: types.SpecStatePassed, NumAttempts: 1},
+						types.SpecReport{LeafNodeType: types.NodeTypeReportAfterSuite, LeafNodeLocation: reportAfterSuiteCL, LeafNodeText: "my report", State: types.SpecStatePassed, NumAttempts: 1},
+					},
+				}
+				reportB := types.Report{
+					SuitePath: "foo",
+					SpecReports: types.SpecReports{
+						types.SpecReport{LeafNodeType: types.NodeTypeReportBeforeSuite, LeafNodeLocation: reportBeforeSuiteCL, State: types.SpecStatePassed, NumAttempts: 1},
+						types.SpecReport{LeafNodeType: types.NodeTypeBeforeSuite, LeafNodeLocation: beforeSuiteCL, State: types.SpecStatePassed, NumAttempts: 1},
+						types.SpecReport{SpecID: "a", LeafNodeType: types.NodeTypeIt, State: types.SpecStateSkipped},
+						types.SpecReport{SpecID: "b", LeafNodeType: types.NodeTypeIt, State: types.SpecStatePassed, NumAttempts: 1},
+						types.SpecReport{LeafNodeType: types.NodeTypeAfterSuite, LeafNodeLocation: afterSuiteCL, State: types.SpecStateFailed, NumAttempts: 1, Failure: types.Failure{Message: "boom"}},
+						types.SpecReport{LeafNodeType: types.NodeTypeReportAfterSuite, LeafNodeLocation: reportAfterSuiteCL, LeafNodeText: "my report", State: types.SpecStatePassed, NumAttempts: 1},
+					},
+				}
+
+				merged := reportA.MergeShard(reportB)
+				Ω(merged.SpecReports).Should(Equal(types.SpecReports{
+					types.SpecReport{LeafNodeType: types.NodeTypeReportBeforeSuite, LeafNodeLocation: reportBeforeSuiteCL, State: types.SpecStatePassed, NumAttempts: 1},
+					types.SpecReport{LeafNodeType: types.NodeTypeBeforeSuite, LeafNodeLocation: beforeSuiteCL, State: types.SpecStatePassed, NumAttempts: 1},
+					types.SpecReport{SpecID: "a", LeafNodeType: types.NodeTypeIt, State: types.SpecStatePassed, NumAttempts: 1},
+					types.SpecReport{SpecID: "b", LeafNodeType: types.NodeTypeIt, State: types.SpecStatePassed, NumAttempts: 1},
+					types.SpecReport{LeafNodeType: types.NodeTypeAfterSuite, LeafNodeLocation: afterSuiteCL, State: types.SpecStateFailed, NumAttempts: 1, Failure: types.Failure{Message: "boom"}},
+					types.SpecReport{LeafNodeType: types.NodeTypeReportAfterSuite, LeafNodeLocation: reportAfterSuiteCL, LeafNodeText: "my report", State: types.SpecStatePassed, NumAttempts: 1},
+				}))
+				Ω(merged.SpecReports.WithLeafNodeType(types.NodeTypeBeforeSuite)).Should(HaveLen(1))
+			})
+		})
 	})
 
 	Describe("NodeType", func() {
@@ -96,6 +182,7 @@ var _ = Describe("Types", func() {
 			Entry(nil, types.NodeTypeSynchronizedAfterSuite, "SynchronizedAfterSuite"),
 			Entry(nil, types.NodeTypeReportBeforeEach, "ReportBeforeEach"),
 			Entry(nil, types.NodeTypeReportAfterEach, "ReportAfterEach"),
+			Entry(nil, types.NodeTypeReportBeforeSuite, "ReportBeforeSuite"),
 			Entry(nil, types.NodeTypeReportAfterSuite, "ReportAfterSuite"),
 			Entry(nil, types.NodeTypeCleanupInvalid, "INVALID CLEANUP NODE"),
 			Entry(nil, types.NodeTypeCleanupAfterEach, "DeferCleanup (AfterEach)"),
@@ -137,6 +224,7 @@ var _ = Describe("Types", func() {
 			Entry("Panicked", types.SpecStatePanicked, "panicked"),
 			Entry("Aborted", types.SpecStateAborted, "aborted"),
 			Entry("Interrupted", types.SpecStateInterrupted, "interrupted"),
+			Entry("Timedout", types.SpecStateTimedout, "timedout"),
 			Entry("Invalid", types.SpecStateInvalid, "INVALID SPEC STATE"),
 		)
 	})
@@ -194,6 +282,13 @@ var _ = Describe("Types", func() {
 				Ω(CurrentSpecReport().MatchesLabelFilter("/fish/")).Should(BeTrue())
 				Ω(CurrentSpecReport().MatchesLabelFilter("dog && !/fish/")).Should(BeFalse())
 			})
+
+			It("supports key/value labels", Label("priority: 2", "tier:core"), func() {
+				Ω(CurrentSpecReport().Labels()).Should(ContainElements("priority:2", "tier:core"))
+				Ω(CurrentSpecReport().MatchesLabelFilter("priority>=2 && tier in (smoke, core)")).Should(BeTrue())
+				Ω(CurrentSpecReport().MatchesLabelFilter("dog && has(tier)")).Should(BeTrue())
+				Ω(CurrentSpecReport().MatchesLabelFilter("priority<2 || tier:smoke")).Should(BeFalse())
+			})
 		})
 
 		It("can report on whether state is a failed state", func() {
@@ -204,6 +299,7 @@ var _ = Describe("Types", func() {
 			Ω(types.SpecReport{State: types.SpecStatePanicked}.Failed()).Should(BeTrue())
 			Ω(types.SpecReport{State: types.SpecStateAborted}.Failed()).Should(BeTrue())
 			Ω(types.SpecReport{State: types.SpecStateInterrupted}.Failed()).Should(BeTrue())
+			Ω(types.SpecReport{State: types.SpecStateTimedout}.Failed()).Should(BeTrue())
 		})
 
 		It("can return a concatenated set of texts", func() {
@@ -271,9 +367,27 @@ var _ = Describe("Types", func() {
 			})
 
 			Context("with a failure", func() {
+				It("round-trips correctly and doesn't include the FailureStepLocation struct", func() {
+					marshalled, err := json.Marshal(report)
+					Ω(err).ShouldNot(HaveOccurred())
+					Ω(string(marshalled)).ShouldNot(ContainSubstring("FailureStepLocation"))
+					unmarshalled := types.SpecReport{}
+					err = json.Unmarshal(marshalled, &unmarshalled)
+					Ω(err).ShouldNot(HaveOccurred())
+					Ω(unmarshalled).Should(Equal(report))
+				})
+			})
+
+			Context("with a failure that occurred during a step", func() {
+				BeforeEach(func() {
+					report.Failure.FailureStepText = "a step"
+					report.Failure.FailureStepLocation = types.NewCodeLocation(0)
+				})
+
 				It("round-trips correctly", func() {
 					marshalled, err := json.Marshal(report)
 					Ω(err).ShouldNot(HaveOccurred())
+					Ω(string(marshalled)).Should(ContainSubstring("FailureStepLocation"))
 					unmarshalled := types.SpecReport{}
 					err = json.Unmarshal(marshalled, &unmarshalled)
 					Ω(err).ShouldNot(HaveOccurred())
@@ -355,6 +469,7 @@ var _ = Describe("Types", func() {
 					{State: types.SpecStatePassed, NumAttempts: 1},
 					{State: types.SpecStatePassed, NumAttempts: 1},
 					{State: types.SpecStateFailed, NumAttempts: 2},
+					{State: types.SpecStatePassed, NumAttempts: 3, MaxMustPassRepeatedly: 3},
 				}
 
 				Ω(reports.CountOfFlakedSpecs()).Should(Equal(2))